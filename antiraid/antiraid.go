@@ -0,0 +1,386 @@
+// Package antiraid detects raids from the rate of members joining a server
+// and locks the server down while they last.
+//
+// A Detector watches the members joining each server over a sliding window.
+// A burst of joins, or a cluster of joiners with similar names, starts a
+// lockdown: the joiners of the window and everyone joining during the
+// lockdown are banned or given a quarantine role, and alerts are posted.
+// The lockdown is lifted once no member joined for the cooldown:
+//
+//	d := antiraid.New(s)
+//	d.Mode = antiraid.ModeQuarantine
+//	d.QuarantineRoleID = 1234
+//	d.AlertChannelID = "..."
+//	defer d.Start()()
+package antiraid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/embedutil"
+)
+
+// ErrNoQuarantineRole is reported when joiners are to be quarantined
+// and the Detector has no QuarantineRoleID.
+var ErrNoQuarantineRole = errors.New("no quarantine role to give joiners")
+
+// Mode is what a Detector does with members joining during a lockdown.
+type Mode int
+
+// Lockdown modes.
+const (
+	// Only post alerts.
+	ModeAlert Mode = iota
+	// Give joiners the quarantine role.
+	ModeQuarantine
+	// Ban joiners.
+	ModeBan
+)
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case ModeQuarantine:
+		return "quarantine"
+	case ModeBan:
+		return "ban"
+	}
+	return "alert"
+}
+
+type join struct {
+	userID guildrone.UserID
+	name   string
+	at     time.Time
+}
+
+type server struct {
+	joins []join
+
+	locked bool
+	until  time.Time
+	timer  *time.Timer
+	acted  int
+
+	// Incremented by every lockdown, so that the timer of an earlier
+	// lockdown does not lift a later one.
+	gen int
+}
+
+// Detector detects join raids and handles server lockdowns.
+type Detector struct {
+	sync.Mutex
+
+	session *guildrone.Session
+	servers map[guildrone.ServerID]*server
+
+	// Span of the sliding window joins are counted over.
+	Window time.Duration
+
+	// Number of joins within Window starting a lockdown.
+	// Join rate detection is disabled if zero or negative.
+	JoinThreshold int
+
+	// Number of joiners with similar names within Window starting a lockdown.
+	// Name detection is disabled if zero.
+	SimilarThreshold int
+
+	// How alike two names must be to be similar, from 0 to 1.
+	NameSimilarity float64
+
+	// Time without joins after which a lockdown is lifted.
+	Cooldown time.Duration
+
+	// What is done with joiners during a lockdown.
+	Mode Mode
+
+	// Role given to joiners in ModeQuarantine.
+	QuarantineRoleID guildrone.RoleID
+
+	// Reason used for bans in ModeBan.
+	BanReason string
+
+	// Channel alerts are posted to, not posted if empty.
+	AlertChannelID guildrone.ChannelID
+
+	// Called with the errors of handling joiners, ignored if nil.
+	OnError func(err error)
+}
+
+// New creates a Detector acting through the session.
+func New(s *guildrone.Session) *Detector {
+	return &Detector{
+		session:          s,
+		servers:          map[guildrone.ServerID]*server{},
+		Window:           10 * time.Second,
+		JoinThreshold:    10,
+		SimilarThreshold: 4,
+		NameSimilarity:   0.8,
+		Cooldown:         5 * time.Minute,
+		Mode:             ModeAlert,
+		BanReason:        "Banned by anti-raid",
+	}
+}
+
+// Start adds the event handler of the detector to the session.
+// It returns a function removing it.
+func (d *Detector) Start() func() {
+	return d.session.AddHandler(d.onTeamMemberJoined)
+}
+
+func (d *Detector) onTeamMemberJoined(s *guildrone.Session, m *guildrone.TeamMemberJoined) {
+	at := m.Member.JoinedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+	d.Join(m.ServerID, m.Member.User.ID, m.Member.User.Name, at)
+}
+
+// Join records a member joining a server, starts a lockdown if it
+// completes a raid pattern and acts on the member during a lockdown.
+// serverID : The ID of a Server.
+// userID   : The ID of the User who joined.
+// name     : The name of the User.
+// at       : When the User joined.
+func (d *Detector) Join(serverID guildrone.ServerID, userID guildrone.UserID, name string, at time.Time) {
+	d.Lock()
+	srv := d.server(serverID)
+
+	srv.joins = append(srv.joins, join{userID: userID, name: name, at: at})
+	srv.prune(at, d.Window)
+
+	if srv.locked {
+		srv.acted++
+		d.extend(serverID, srv)
+		d.Unlock()
+
+		d.act(serverID, []guildrone.UserID{userID})
+		return
+	}
+
+	var suspects []guildrone.UserID
+	reason := ""
+	if d.JoinThreshold > 0 && len(srv.joins) >= d.JoinThreshold {
+		reason = fmt.Sprintf("%d members joined within %s", len(srv.joins), d.Window)
+		for _, j := range srv.joins {
+			suspects = append(suspects, j.userID)
+		}
+	} else if cluster := srv.similar(name, d.NameSimilarity); d.SimilarThreshold > 0 && len(cluster) >= d.SimilarThreshold {
+		reason = fmt.Sprintf("%d members with names similar to %q joined within %s", len(cluster), name, d.Window)
+		suspects = cluster
+	}
+	if reason == "" {
+		d.Unlock()
+		return
+	}
+
+	d.lock(serverID, srv)
+	srv.acted = len(suspects)
+	mode, cooldown := d.Mode, d.Cooldown
+	d.Unlock()
+
+	d.alert(serverID, "Raid detected, server locked down", fmt.Sprintf("%s.\nJoiners are handled in %s mode until no member joins for %s.", reason, mode, cooldown), embedutil.ColorDanger)
+	d.act(serverID, suspects)
+}
+
+// Lockdown starts a lockdown of a server by hand.
+// It is lifted like a detected one.
+// serverID : The ID of a Server.
+// reason   : The reason shown in the alert.
+func (d *Detector) Lockdown(serverID guildrone.ServerID, reason string) {
+	d.Lock()
+	srv := d.server(serverID)
+	if srv.locked {
+		d.Unlock()
+		return
+	}
+	d.lock(serverID, srv)
+	d.Unlock()
+
+	d.alert(serverID, "Server locked down", reason, embedutil.ColorDanger)
+}
+
+// Lift ends the lockdown of a server.
+// serverID : The ID of a Server.
+func (d *Detector) Lift(serverID guildrone.ServerID) {
+	d.Lock()
+	srv, ok := d.servers[serverID]
+	if !ok || !srv.locked {
+		d.Unlock()
+		return
+	}
+	acted := d.lift(srv)
+	d.Unlock()
+
+	d.alertLifted(serverID, acted)
+}
+
+// Locked returns true if a server is locked down.
+// serverID : The ID of a Server.
+func (d *Detector) Locked(serverID guildrone.ServerID) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	srv, ok := d.servers[serverID]
+	return ok && srv.locked
+}
+
+func (d *Detector) server(serverID guildrone.ServerID) *server {
+	srv, ok := d.servers[serverID]
+	if !ok {
+		srv = &server{}
+		d.servers[serverID] = srv
+	}
+	return srv
+}
+
+// lock starts the lockdown of srv, d must be locked.
+func (d *Detector) lock(serverID guildrone.ServerID, srv *server) {
+	srv.locked = true
+	srv.acted = 0
+	srv.gen++
+	d.extend(serverID, srv)
+}
+
+// lift ends the lockdown of srv and returns the number of joiners
+// handled during it, d must be locked.
+func (d *Detector) lift(srv *server) int {
+	srv.locked = false
+	if srv.timer != nil {
+		srv.timer.Stop()
+		srv.timer = nil
+	}
+	srv.joins = nil
+	return srv.acted
+}
+
+// extend pushes the end of the lockdown of srv back by the cooldown,
+// d must be locked.
+func (d *Detector) extend(serverID guildrone.ServerID, srv *server) {
+	srv.until = time.Now().Add(d.Cooldown)
+	if srv.timer == nil {
+		gen := srv.gen
+		srv.timer = time.AfterFunc(d.Cooldown, func() { d.expire(serverID, gen) })
+	}
+}
+
+// expire lifts the lockdown gen of a server if its cooldown is over,
+// and waits for the rest of it otherwise. Nothing is done if the
+// lockdown was lifted since, even if another one started.
+func (d *Detector) expire(serverID guildrone.ServerID, gen int) {
+	d.Lock()
+	srv, ok := d.servers[serverID]
+	if !ok || !srv.locked || srv.gen != gen {
+		d.Unlock()
+		return
+	}
+	if left := time.Until(srv.until); left > 0 {
+		srv.timer = time.AfterFunc(left, func() { d.expire(serverID, gen) })
+		d.Unlock()
+		return
+	}
+	acted := d.lift(srv)
+	d.Unlock()
+
+	d.alertLifted(serverID, acted)
+}
+
+// act handles the joiners according to the mode.
+func (d *Detector) act(serverID guildrone.ServerID, userIDs []guildrone.UserID) {
+	d.Lock()
+	mode, role, reason := d.Mode, d.QuarantineRoleID, d.BanReason
+	d.Unlock()
+
+	var results guildrone.BulkResults
+	switch mode {
+	case ModeQuarantine:
+		if role == 0 {
+			d.report(ErrNoQuarantineRole)
+			return
+		}
+		results = d.session.ServerMembersRoleAddBulk(context.Background(), serverID, userIDs, role, nil)
+	case ModeBan:
+		results = d.session.ServerMembersBanBulk(context.Background(), serverID, userIDs, reason, nil)
+	default:
+		return
+	}
+
+	failed := results.Failed()
+	if len(failed) == 0 {
+		return
+	}
+	lines := make([]string, len(failed))
+	for i, f := range failed {
+		lines[i] = fmt.Sprintf("<@%s>: %s", f.UserID, f.Err)
+	}
+	d.alert(serverID, "Failed to handle joiners", strings.Join(lines, "\n"), embedutil.ColorDanger)
+}
+
+// alertLifted posts the alert of a lifted lockdown.
+func (d *Detector) alertLifted(serverID guildrone.ServerID, acted int) {
+	d.alert(serverID, "Lockdown lifted", fmt.Sprintf("%d joiners were handled during the lockdown.", acted), embedutil.ColorSuccess)
+}
+
+func (d *Detector) report(err error) {
+	d.Lock()
+	onError := d.OnError
+	d.Unlock()
+	if onError != nil {
+		onError(err)
+	}
+}
+
+// alert posts an alert embed to the alert channel.
+func (d *Detector) alert(serverID guildrone.ServerID, title, description string, color int) {
+	d.Lock()
+	channelID := d.AlertChannelID
+	d.Unlock()
+	if channelID == "" {
+		return
+	}
+
+	description = embedutil.Truncate(description, embedutil.MaxDescription)
+	now := time.Now().UTC()
+	_, _ = d.session.ChannelMessageCreateComplex(channelID, &guildrone.MessageCreate{
+		Embeds: []guildrone.ChatEmbed{{
+			Title:       title,
+			Description: description,
+			Color:       color,
+			Fields: []guildrone.ChatEmbedField{
+				{Name: "Server", Value: string(serverID)},
+			},
+			Timestamp: &now,
+		}},
+	})
+}
+
+// prune drops the joins older than the window.
+func (srv *server) prune(now time.Time, window time.Duration) {
+	i := 0
+	for i < len(srv.joins) && now.Sub(srv.joins[i].at) > window {
+		i++
+	}
+	srv.joins = srv.joins[i:]
+}
+
+// similar returns the joiners of the window with a name similar to name.
+func (srv *server) similar(name string, similarity float64) []guildrone.UserID {
+	n := normalizeName(name)
+	if n == "" {
+		return nil
+	}
+
+	var ids []guildrone.UserID
+	for _, j := range srv.joins {
+		if nameSimilarity(n, normalizeName(j.name)) >= similarity {
+			ids = append(ids, j.userID)
+		}
+	}
+	return ids
+}
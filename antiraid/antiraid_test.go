@@ -0,0 +1,186 @@
+package antiraid
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// recordingTransport records the requests sent and answers them with an
+// empty object.
+type recordingTransport struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	r.requests = append(r.requests, req.Method+" "+strings.TrimPrefix(req.URL.Path, "/api/v1"))
+	r.mu.Unlock()
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+func newTestDetector() (*Detector, *recordingTransport) {
+	transport := &recordingTransport{}
+	d := New(&guildrone.Session{Client: &http.Client{Transport: transport}})
+	d.Cooldown = time.Hour
+	return d, transport
+}
+
+func TestServerSimilar(t *testing.T) {
+	srv := &server{joins: []join{
+		{userID: "u1", name: "Raider_01"},
+		{userID: "u2", name: "raider02"},
+		{userID: "u3", name: "Alice"},
+		{userID: "u4", name: "raidr"},
+		{userID: "u5", name: "1234"},
+	}}
+
+	tests := []struct {
+		name       string
+		similarity float64
+		want       []guildrone.UserID
+	}{
+		{"Raider99", 0.8, []guildrone.UserID{"u1", "u2", "u4"}},
+		{"Raider99", 1, []guildrone.UserID{"u1", "u2"}},
+		{"alicia", 0.6, []guildrone.UserID{"u3"}},
+		{"Bob", 0.8, nil},
+		{"42", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := srv.similar(tt.name, tt.similarity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("similar(%q, %v) = %v, want %v", tt.name, tt.similarity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	type joiner struct {
+		name  string
+		after time.Duration
+	}
+	raiders := []joiner{{"raider1", 0}, {"raider2", time.Second}, {"raider3", 2 * time.Second}}
+	others := []joiner{{"alice", 0}, {"bob", time.Second}, {"carol", 2 * time.Second}}
+
+	tests := []struct {
+		name             string
+		joinThreshold    int
+		similarThreshold int
+		joiners          []joiner
+		want             bool
+	}{
+		{"below join threshold", 4, 0, others, false},
+		{"at join threshold", 3, 0, others, true},
+		{"outside the window", 3, 0, []joiner{{"alice", 0}, {"bob", 6 * time.Second}, {"carol", 12 * time.Second}}, false},
+		{"join threshold disabled", 0, 0, others[:1], false},
+		{"negative join threshold", -1, 0, others[:1], false},
+		{"similar names", 10, 3, raiders, true},
+		{"names not similar", 10, 3, others, false},
+		{"similar names disabled", 10, 0, raiders, false},
+	}
+
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := newTestDetector()
+			d.JoinThreshold = tt.joinThreshold
+			d.SimilarThreshold = tt.similarThreshold
+			defer d.Lift("s1")
+
+			for _, j := range tt.joiners {
+				d.Join("s1", guildrone.UserID(j.name), j.name, at.Add(j.after))
+			}
+			if got := d.Locked("s1"); got != tt.want {
+				t.Errorf("Locked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinModes(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    Mode
+		role    guildrone.RoleID
+		want    []string
+		wantErr error
+	}{
+		{"alert", ModeAlert, 5, nil, nil},
+		{"quarantine", ModeQuarantine, 5, []string{
+			"PUT /servers/s1/members/u1/roles/5",
+			"PUT /servers/s1/members/u2/roles/5",
+			"PUT /servers/s1/members/u3/roles/5",
+		}, nil},
+		{"quarantine without a role", ModeQuarantine, 0, nil, ErrNoQuarantineRole},
+		{"ban", ModeBan, 0, []string{
+			"POST /servers/s1/bans/u1",
+			"POST /servers/s1/bans/u2",
+			"POST /servers/s1/bans/u3",
+		}, nil},
+	}
+
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, transport := newTestDetector()
+			d.JoinThreshold = 2
+			d.Mode = tt.mode
+			d.QuarantineRoleID = tt.role
+			var err error
+			d.OnError = func(e error) { err = e }
+			defer d.Lift("s1")
+
+			// The third joiner arrives during the lockdown.
+			d.Join("s1", "u1", "alice", at)
+			d.Join("s1", "u2", "bob", at.Add(time.Second))
+			d.Join("s1", "u3", "carol", at.Add(2*time.Second))
+
+			got := transport.requests
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("requests = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("OnError() got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpire(t *testing.T) {
+	d, _ := newTestDetector()
+
+	d.Lockdown("s1", "test")
+	first := d.servers["s1"].gen
+	d.Lift("s1")
+	d.Lockdown("s1", "test")
+
+	// The timer of the lifted lockdown fires late.
+	d.servers["s1"].until = time.Now().Add(-time.Second)
+	d.expire("s1", first)
+	if !d.Locked("s1") {
+		t.Fatal("expire() of an earlier lockdown lifted the current one")
+	}
+
+	d.expire("s1", d.servers["s1"].gen)
+	if d.Locked("s1") {
+		t.Error("expire() after the cooldown did not lift the lockdown")
+	}
+}
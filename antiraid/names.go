@@ -0,0 +1,64 @@
+package antiraid
+
+import (
+	"strings"
+	"unicode"
+)
+
+// normalizeName lowercases a name and drops everything but its letters,
+// so that "Raider_01" and "raider02" compare equal.
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// nameSimilarity returns how alike two normalized names are, from 0 for
+// entirely different names to 1 for equal ones, based on their edit distance.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the number of single rune edits turning a into b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,75 @@
+package antiraid
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Raider_01", "raider"},
+		{"raider02", "raider"},
+		{"  Über Bot!", "überbot"},
+		{"1234", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeName(tt.name); got != tt.want {
+				t.Errorf("normalizeName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"raider", "raider", 0},
+		{"raider", "raiders", 1},
+		{"raider", "rader", 1},
+		{"raider", "ruider", 1},
+		{"kitten", "sitting", 3},
+		{"über", "uber", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := levenshtein([]rune(tt.a), []rune(tt.b)); got != tt.want {
+				t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := levenshtein([]rune(tt.b), []rune(tt.a)); got != tt.want {
+				t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 0},
+		{"raider", "raider", 1},
+		{"raider", "raiders", 1 - 1.0/7},
+		{"abcd", "wxyz", 0},
+		{"abcd", "abxy", 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := nameSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("nameSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Package auditlog records moderation events of a Session into a store of
+// normalised entries, which can be queried by user or action and rendered
+// into embeds.
+//
+//	store, err := auditlog.OpenJSONLStore("audit.jsonl")
+//	if err != nil {
+//		return err
+//	}
+//	defer store.Close()
+//
+//	l := auditlog.New(s, store)
+//	defer l.Start()()
+//
+//	entries, err := store.Query(auditlog.Query{ServerID: id, UserID: userID, Limit: 10})
+package auditlog
+
+import (
+	"strings"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Action is the kind of an audit log entry.
+type Action string
+
+// Actions recorded from events.
+const (
+	ActionMemberJoin        Action = "member_join"
+	ActionMemberLeave       Action = "member_leave"
+	ActionMemberKick        Action = "member_kick"
+	ActionMemberBan         Action = "member_ban"
+	ActionMemberUnban       Action = "member_unban"
+	ActionMemberUpdate      Action = "member_update"
+	ActionMemberRolesUpdate Action = "member_roles_update"
+	ActionMessageDelete     Action = "message_delete"
+	ActionRoleCreate        Action = "role_create"
+	ActionRoleUpdate        Action = "role_update"
+	ActionRoleDelete        Action = "role_delete"
+	ActionChannelCreate     Action = "channel_create"
+	ActionChannelUpdate     Action = "channel_update"
+)
+
+// TargetType is the kind of object an entry is about.
+type TargetType string
+
+// Target types.
+const (
+	TargetUser    TargetType = "user"
+	TargetMessage TargetType = "message"
+	TargetRole    TargetType = "role"
+	TargetChannel TargetType = "channel"
+)
+
+// Entry is a single audit log record.
+type Entry struct {
+	// ID assigned by the Store, increasing with every entry
+	ID int64 `json:"id"`
+
+	ServerID guildrone.ServerID `json:"serverId"`
+	Action   Action             `json:"action"`
+
+	// User who performed the action, empty if Guilded does not tell
+	ActorID guildrone.UserID `json:"actorId,omitempty"`
+
+	// Object the action was performed on
+	TargetType TargetType `json:"targetType"`
+	TargetID   string     `json:"targetId"`
+
+	// User the target belongs to, or the target itself for user targets
+	TargetUserID guildrone.UserID `json:"targetUserId,omitempty"`
+
+	Reason string `json:"reason,omitempty"`
+
+	// Additional data depending on the action, like a new nickname
+	Details map[string]string `json:"details,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Log records the moderation events of a session into a store.
+type Log struct {
+	session *guildrone.Session
+	store   Store
+
+	// Called with the errors of the store, ignored if nil.
+	OnError func(err error)
+}
+
+// New creates a Log recording the events of the session into the store.
+func New(s *guildrone.Session, store Store) *Log {
+	return &Log{session: s, store: store}
+}
+
+// Store returns the store entries are recorded into.
+func (l *Log) Store() Store {
+	return l.store
+}
+
+// Start adds the event handlers of the log to the session.
+// It returns a function removing them.
+func (l *Log) Start() func() {
+	removers := []func(){
+		l.session.AddHandler(l.onTeamMemberJoined),
+		l.session.AddHandler(l.onTeamMemberRemoved),
+		l.session.AddHandler(l.onTeamMemberBanned),
+		l.session.AddHandler(l.onTeamMemberUnbanned),
+		l.session.AddHandler(l.onTeamMemberUpdated),
+		l.session.AddHandler(l.onTeamRolesUpdated),
+		l.session.AddHandler(l.onChatMessageDeleted),
+		l.session.AddHandler(l.onRoleCreated),
+		l.session.AddHandler(l.onRoleUpdated),
+		l.session.AddHandler(l.onRoleDeleted),
+		l.session.AddHandler(l.onTeamChannelCreated),
+		l.session.AddHandler(l.onTeamChannelUpdated),
+	}
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+	}
+}
+
+// Record appends an entry to the store.
+// CreatedAt is set to the current time if zero.
+// It can be used to record actions not dispatched as events,
+// like the ones taken by a bot.
+func (l *Log) Record(e *Entry) error {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	return l.store.Append(e)
+}
+
+func (l *Log) record(e *Entry) {
+	if err := l.Record(e); err != nil && l.OnError != nil {
+		l.OnError(err)
+	}
+}
+
+func userEntry(serverID guildrone.ServerID, action Action, userID guildrone.UserID) *Entry {
+	return &Entry{
+		ServerID:     serverID,
+		Action:       action,
+		TargetType:   TargetUser,
+		TargetID:     string(userID),
+		TargetUserID: userID,
+	}
+}
+
+func (l *Log) onTeamMemberJoined(s *guildrone.Session, m *guildrone.TeamMemberJoined) {
+	e := userEntry(m.ServerID, ActionMemberJoin, m.Member.User.ID)
+	e.Details = map[string]string{"name": m.Member.User.Name}
+	e.CreatedAt = m.Member.JoinedAt
+	l.record(e)
+}
+
+// onTeamMemberRemoved records leaves and kicks.
+// Removals caused by a ban are recorded by onTeamMemberBanned,
+// which knows the moderator and the reason.
+func (l *Log) onTeamMemberRemoved(s *guildrone.Session, m *guildrone.TeamMemberRemoved) {
+	switch {
+	case m.IsBan:
+		return
+	case m.IsKick:
+		l.record(userEntry(m.ServerID, ActionMemberKick, m.UserID))
+	default:
+		l.record(userEntry(m.ServerID, ActionMemberLeave, m.UserID))
+	}
+}
+
+func (l *Log) onTeamMemberBanned(s *guildrone.Session, b *guildrone.TeamMemberBanned) {
+	l.record(banEntry(b.ServerID, ActionMemberBan, &b.ServerMemberBan))
+}
+
+func (l *Log) onTeamMemberUnbanned(s *guildrone.Session, b *guildrone.TeamMemberUnbanned) {
+	e := banEntry(b.ServerID, ActionMemberUnban, &b.ServerMemberBan)
+	// The ban is the one being lifted, its author and time are not the unban's.
+	e.ActorID = ""
+	e.CreatedAt = time.Time{}
+	l.record(e)
+}
+
+func banEntry(serverID guildrone.ServerID, action Action, b *guildrone.ServerMemberBan) *Entry {
+	e := userEntry(serverID, action, b.UserSummary.ID)
+	e.ActorID = b.CreatedBy
+	e.Reason = b.Reason
+	e.Details = map[string]string{"name": b.UserSummary.Name}
+	e.CreatedAt = b.CreatedAt
+	return e
+}
+
+func (l *Log) onTeamMemberUpdated(s *guildrone.Session, m *guildrone.TeamMemberUpdated) {
+	e := userEntry(m.ServerID, ActionMemberUpdate, m.UserInfo.ID)
+	e.Details = map[string]string{"nickname": m.UserInfo.Nickname}
+	l.record(e)
+}
+
+func (l *Log) onTeamRolesUpdated(s *guildrone.Session, r *guildrone.TeamRolesUpdated) {
+	for _, m := range r.MemberRoleIds {
+		e := userEntry(r.ServerID, ActionMemberRolesUpdate, m.UserID)
+		e.Details = map[string]string{"roles": joinRoleIDs(m.RoleIDs)}
+		l.record(e)
+	}
+}
+
+func (l *Log) onChatMessageDeleted(s *guildrone.Session, m *guildrone.ChatMessageDeleted) {
+	msg := &m.Message
+	e := &Entry{
+		ServerID:     m.ServerID,
+		Action:       ActionMessageDelete,
+		TargetType:   TargetMessage,
+		TargetID:     string(msg.ID),
+		TargetUserID: msg.CreatedBy,
+		Details:      map[string]string{"channelId": string(msg.ChannelID)},
+	}
+	if msg.Content != "" {
+		e.Details["content"] = msg.Content
+	}
+	l.record(e)
+}
+
+func roleEntry(serverID guildrone.ServerID, action Action, r *guildrone.Role) *Entry {
+	return &Entry{
+		ServerID:   serverID,
+		Action:     action,
+		TargetType: TargetRole,
+		TargetID:   r.ID.String(),
+		Details:    map[string]string{"name": r.Name},
+	}
+}
+
+func (l *Log) onRoleCreated(s *guildrone.Session, r *guildrone.RoleCreated) {
+	e := roleEntry(r.ServerID, ActionRoleCreate, &r.Role)
+	e.CreatedAt = r.Role.CreatedAt
+	l.record(e)
+}
+
+func (l *Log) onRoleUpdated(s *guildrone.Session, r *guildrone.RoleUpdated) {
+	e := roleEntry(r.ServerID, ActionRoleUpdate, &r.Role)
+	e.Details["permissions"] = joinPermissions(r.Role.Permissions)
+	l.record(e)
+}
+
+func (l *Log) onRoleDeleted(s *guildrone.Session, r *guildrone.RoleDeleted) {
+	l.record(roleEntry(r.ServerID, ActionRoleDelete, &r.Role))
+}
+
+func channelEntry(serverID guildrone.ServerID, action Action, c *guildrone.ServerChannel) *Entry {
+	return &Entry{
+		ServerID:   serverID,
+		Action:     action,
+		TargetType: TargetChannel,
+		TargetID:   string(c.ID),
+		Details:    map[string]string{"name": c.Name},
+	}
+}
+
+func (l *Log) onTeamChannelCreated(s *guildrone.Session, c *guildrone.TeamChannelCreated) {
+	e := channelEntry(c.ServerID, ActionChannelCreate, &c.Channel)
+	e.ActorID = c.Channel.CreatedBy
+	e.CreatedAt = c.Channel.CreatedAt
+	l.record(e)
+}
+
+func (l *Log) onTeamChannelUpdated(s *guildrone.Session, c *guildrone.TeamChannelUpdated) {
+	l.record(channelEntry(c.ServerID, ActionChannelUpdate, &c.Channel))
+}
+
+func joinRoleIDs(ids []guildrone.RoleID) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return strings.Join(s, ",")
+}
+
+func joinPermissions(p guildrone.Permissions) string {
+	list := p.List()
+	s := make([]string, len(list))
+	for i, perm := range list {
+		s[i] = string(perm)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,145 @@
+package auditlog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/embedutil"
+)
+
+var actionTitles = map[Action]string{
+	ActionMemberJoin:        "Member joined",
+	ActionMemberLeave:       "Member left",
+	ActionMemberKick:        "Member kicked",
+	ActionMemberBan:         "Member banned",
+	ActionMemberUnban:       "Member unbanned",
+	ActionMemberUpdate:      "Member updated",
+	ActionMemberRolesUpdate: "Member roles updated",
+	ActionMessageDelete:     "Message deleted",
+	ActionRoleCreate:        "Role created",
+	ActionRoleUpdate:        "Role updated",
+	ActionRoleDelete:        "Role deleted",
+	ActionChannelCreate:     "Channel created",
+	ActionChannelUpdate:     "Channel updated",
+}
+
+// Title returns a human readable name of the action.
+func (a Action) Title() string {
+	if t, ok := actionTitles[a]; ok {
+		return t
+	}
+	return string(a)
+}
+
+func (a Action) color() int {
+	switch a {
+	case ActionMemberKick, ActionMemberBan, ActionMessageDelete, ActionRoleDelete:
+		return embedutil.ColorDanger
+	case ActionMemberUnban, ActionMemberJoin:
+		return embedutil.ColorSuccess
+	case ActionMemberRolesUpdate, ActionRoleUpdate, ActionMemberUpdate:
+		return embedutil.ColorWarning
+	}
+	return embedutil.ColorInfo
+}
+
+// Embed renders the entry as an embed.
+func (e *Entry) Embed() guildrone.ChatEmbed {
+	fields := []guildrone.ChatEmbedField{
+		{Name: "Target", Value: e.target(), Inline: true},
+	}
+	if e.ActorID != "" {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Moderator", Value: mention(e.ActorID), Inline: true})
+	}
+	if e.Reason != "" {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Reason", Value: embedutil.Truncate(e.Reason, embedutil.MaxFieldValue)})
+	}
+
+	keys := make([]string, 0, len(e.Details))
+	for k := range e.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v := e.Details[k]; v != "" {
+			fields = append(fields, guildrone.ChatEmbedField{Name: k, Value: embedutil.Truncate(v, embedutil.MaxFieldValue)})
+		}
+	}
+
+	embed := guildrone.ChatEmbed{
+		Title:  e.Action.Title(),
+		Color:  e.Action.color(),
+		Fields: fields,
+		Footer: &guildrone.ChatEmbedFooter{Text: fmt.Sprintf("Entry #%d", e.ID)},
+	}
+	if !e.CreatedAt.IsZero() {
+		t := e.CreatedAt
+		embed.Timestamp = &t
+	}
+	return embed
+}
+
+// ListEmbed renders entries as a single embed with one line per entry,
+// as many as fit in the description.
+func ListEmbed(title string, entries []*Entry) guildrone.ChatEmbed {
+	var b strings.Builder
+	shown := 0
+	for _, e := range entries {
+		line := e.line() + "\n"
+		if b.Len()+len(line) > embedutil.MaxDescription {
+			break
+		}
+		b.WriteString(line)
+		shown++
+	}
+
+	embed := guildrone.ChatEmbed{
+		Title:       title,
+		Description: b.String(),
+		Color:       embedutil.ColorInfo,
+	}
+	if len(entries) == 0 {
+		embed.Description = "No entries."
+	} else if shown < len(entries) {
+		embed.Footer = &guildrone.ChatEmbedFooter{Text: fmt.Sprintf("%d of %d entries shown", shown, len(entries))}
+	}
+	return embed
+}
+
+// line is the one line summary of the entry used by ListEmbed.
+func (e *Entry) line() string {
+	s := fmt.Sprintf("`#%d` %s: %s", e.ID, e.Action.Title(), e.target())
+	if e.ActorID != "" {
+		s += " by " + mention(e.ActorID)
+	}
+	if e.Reason != "" {
+		s += " (" + embedutil.Truncate(e.Reason, 100) + ")"
+	}
+	if !e.CreatedAt.IsZero() {
+		s += " " + e.CreatedAt.UTC().Format("2006-01-02 15:04")
+	}
+	return s
+}
+
+func (e *Entry) target() string {
+	switch e.TargetType {
+	case TargetUser:
+		return mention(e.TargetUserID)
+	case TargetMessage:
+		if e.TargetUserID != "" {
+			return "message by " + mention(e.TargetUserID)
+		}
+		return "message " + e.TargetID
+	case TargetRole, TargetChannel:
+		if name := e.Details["name"]; name != "" {
+			return name
+		}
+	}
+	return string(e.TargetType) + " " + e.TargetID
+}
+
+func mention(id guildrone.UserID) string {
+	return "<@" + string(id) + ">"
+}
@@ -0,0 +1,235 @@
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Store persists audit log entries.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Append assigns the next ID to the entry and stores it.
+	Append(e *Entry) error
+
+	// Query returns the entries matching the query, newest first.
+	Query(q Query) ([]*Entry, error)
+}
+
+// Query selects audit log entries.
+// Zero fields match every entry.
+type Query struct {
+	ServerID guildrone.ServerID
+
+	// Matches entries performed by or on the user
+	UserID guildrone.UserID
+
+	// Matches entries with any of the actions
+	Actions []Action
+
+	// Matches entries created at or after Since and before Until
+	Since time.Time
+	Until time.Time
+
+	// Maximum number of entries returned
+	Limit int
+}
+
+// Match returns true if the entry matches the query.
+func (q *Query) Match(e *Entry) bool {
+	if q.ServerID != "" && e.ServerID != q.ServerID {
+		return false
+	}
+	if q.UserID != "" && e.ActorID != q.UserID && e.TargetUserID != q.UserID {
+		return false
+	}
+	if len(q.Actions) > 0 {
+		found := false
+		for _, a := range q.Actions {
+			if a == e.Action {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !q.Since.IsZero() && e.CreatedAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !e.CreatedAt.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// MemoryStore is a Store keeping entries in memory.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []*Entry
+	lastID  int64
+
+	// Maximum number of entries kept, the oldest are dropped first.
+	// Unlimited if zero.
+	MaxEntries int
+}
+
+// NewMemoryStore creates a MemoryStore keeping at most maxEntries entries,
+// or all of them if maxEntries is zero.
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{MaxEntries: maxEntries}
+}
+
+// Append implements Store.
+func (m *MemoryStore) Append(e *Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lastID++
+	e.ID = m.lastID
+	m.entries = append(m.entries, e)
+	if m.MaxEntries > 0 && len(m.entries) > m.MaxEntries {
+		m.entries = append(m.entries[:0:0], m.entries[len(m.entries)-m.MaxEntries:]...)
+	}
+	return nil
+}
+
+// Query implements Store.
+func (m *MemoryStore) Query(q Query) ([]*Entry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var found []*Entry
+	for i := len(m.entries) - 1; i >= 0; i-- {
+		if q.Match(m.entries[i]) {
+			found = append(found, m.entries[i])
+			if q.Limit > 0 && len(found) == q.Limit {
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+// JSONLStore is a Store appending entries to a file,
+// one JSON object per line.
+// Queries read the whole file.
+type JSONLStore struct {
+	mu     sync.Mutex
+	file   *os.File
+	lastID int64
+}
+
+// OpenJSONLStore opens or creates the JSONL file at path.
+// A partly written last line, left by a crash while appending, is removed.
+func OpenJSONLStore(path string) (*JSONLStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &JSONLStore{file: f}
+	size, err := s.scan(func(e *Entry) {
+		if e.ID > s.lastID {
+			s.lastID = e.ID
+		}
+	})
+	if err == nil {
+		// Drop a partly written last line so the next entry starts on its own line.
+		err = f.Truncate(size)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Append implements Store.
+func (s *JSONLStore) Append(e *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.ID = s.lastID + 1
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err = s.file.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	s.lastID = e.ID
+	return nil
+}
+
+// Query implements Store.
+func (s *JSONLStore) Query(q Query) ([]*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var found []*Entry
+	_, err := s.scan(func(e *Entry) {
+		if q.Match(e) {
+			found = append(found, e)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are stored oldest first.
+	for i, j := 0, len(found)-1; i < j; i, j = i+1, j-1 {
+		found[i], found[j] = found[j], found[i]
+	}
+	if q.Limit > 0 && len(found) > q.Limit {
+		found = found[:q.Limit]
+	}
+	return found, nil
+}
+
+// Close closes the file.
+func (s *JSONLStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// scan decodes every entry of the file, s.mu must be locked.
+// A last line without a trailing newline was cut short by a crash while
+// appending, it is skipped. scan returns the size of the file without it.
+func (s *JSONLStore) scan(fn func(e *Entry)) (int64, error) {
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	var size int64
+	r := bufio.NewReader(s.file)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return size, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		size += int64(len(line))
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err = json.Unmarshal(line, &e); err != nil {
+			return 0, err
+		}
+		fn(&e)
+	}
+}
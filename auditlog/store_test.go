@@ -0,0 +1,176 @@
+package auditlog
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueryMatch(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ban := &Entry{ServerID: "s1", Action: ActionMemberBan, ActorID: "mod", TargetType: TargetUser, TargetID: "u2", TargetUserID: "u2", CreatedAt: at}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  bool
+	}{
+		{"zero query", Query{}, true},
+		{"server", Query{ServerID: "s1"}, true},
+		{"other server", Query{ServerID: "s2"}, false},
+		{"target user", Query{UserID: "u2"}, true},
+		{"actor", Query{UserID: "mod"}, true},
+		{"other user", Query{UserID: "u1"}, false},
+		{"one of the actions", Query{Actions: []Action{ActionMemberKick, ActionMemberBan}}, true},
+		{"other actions", Query{Actions: []Action{ActionMemberKick}}, false},
+		{"since is inclusive", Query{Since: at}, true},
+		{"after since", Query{Since: at.Add(time.Second)}, false},
+		{"until is exclusive", Query{Until: at}, false},
+		{"before until", Query{Until: at.Add(time.Second)}, true},
+		{"all fields", Query{ServerID: "s1", UserID: "u2", Actions: []Action{ActionMemberBan}, Since: at, Until: at.Add(time.Hour)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Match(ban); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testEntries() []*Entry {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []*Entry{
+		{ServerID: "s1", Action: ActionMemberJoin, TargetType: TargetUser, TargetID: "u1", TargetUserID: "u1", CreatedAt: at},
+		{ServerID: "s1", Action: ActionMemberBan, ActorID: "mod", TargetType: TargetUser, TargetID: "u2", TargetUserID: "u2", Reason: "spam", CreatedAt: at.Add(time.Hour)},
+		{ServerID: "s2", Action: ActionMemberKick, ActorID: "mod", TargetType: TargetUser, TargetID: "u1", TargetUserID: "u1", CreatedAt: at.Add(2 * time.Hour)},
+	}
+}
+
+// appendEntries appends testEntries to s and returns the IDs s gives
+// back for q, newest first.
+func appendEntries(t *testing.T, s Store, q Query) []int64 {
+	t.Helper()
+
+	for _, e := range testEntries() {
+		if err := s.Append(e); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	entries, err := s.Query(q)
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	var ids []int64
+	for _, e := range entries {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func TestMemoryStoreQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		want  []int64
+	}{
+		{"newest first", Query{}, []int64{3, 2, 1}},
+		{"matching", Query{UserID: "u1"}, []int64{3, 1}},
+		{"limit", Query{Limit: 1}, []int64{3}},
+		{"limit after matching", Query{ServerID: "s1", Limit: 1}, []int64{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appendEntries(t, NewMemoryStore(0), tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() IDs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreMaxEntries(t *testing.T) {
+	s := NewMemoryStore(2)
+	for _, e := range testEntries() {
+		if err := s.Append(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := s.Query(Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].ID != 3 || entries[1].ID != 2 {
+		t.Errorf("Query() kept %d entries, want the 2 newest", len(entries))
+	}
+}
+
+func TestJSONLStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	s, err := OpenJSONLStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := appendEntries(t, s, Query{ServerID: "s1", Limit: 1}), []int64{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query() IDs = %v, want %v", got, want)
+	}
+	if err = s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopening continues the IDs and keeps the entries.
+	s, err = OpenJSONLStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	e := &Entry{ServerID: "s1", Action: ActionMemberLeave}
+	if err = s.Append(e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != 4 {
+		t.Errorf("Append() after reopening assigned ID %d, want 4", e.ID)
+	}
+	entries, err := s.Query(Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 {
+		t.Errorf("Query() returned %d entries, want 4", len(entries))
+	}
+}
+
+func TestJSONLStoreTruncatedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	data := `{"id":1,"serverId":"s1","action":"member_join"}` + "\n" + `{"id":2,"serverId":"s1","act`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := OpenJSONLStore(path)
+	if err != nil {
+		t.Fatalf("OpenJSONLStore() error = %v", err)
+	}
+	defer s.Close()
+
+	e := &Entry{ServerID: "s1", Action: ActionMemberLeave}
+	if err = s.Append(e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != 2 {
+		t.Errorf("Append() assigned ID %d, want 2", e.ID)
+	}
+
+	entries, err := s.Query(Query{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].Action != ActionMemberLeave {
+		t.Errorf("Query() = %d entries, want the first entry and the appended one", len(entries))
+	}
+}
@@ -0,0 +1,136 @@
+package automod
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/embedutil"
+)
+
+// act takes the actions of all the violations and logs them.
+func (e *Engine) act(c *Content, violations []Violation) {
+	var actions Action
+	for _, v := range violations {
+		actions |= v.Actions
+	}
+	// A ban removes the member, kicking them first is pointless.
+	if actions.Has(ActionBan) {
+		actions &^= ActionKick
+	}
+
+	e.RLock()
+	timeoutRole, timeout, banReason := e.TimeoutRoleID, e.TimeoutDuration, e.BanReason
+	e.RUnlock()
+
+	s := e.session
+	var errs []string
+	fail := func(action Action, err error) {
+		if err != nil {
+			errs = append(errs, action.String()+": "+err.Error())
+		}
+	}
+
+	if actions.Has(ActionDelete) {
+		if c.TopicID != 0 {
+			fail(ActionDelete, s.ChannelForumTopicDelete(c.ChannelID, c.TopicID))
+		} else if c.MessageID != "" {
+			fail(ActionDelete, s.ChannelMessageDelete(c.ChannelID, c.MessageID))
+		}
+	}
+
+	// A private message is warned about with a private reply, which
+	// needs the message to still exist.
+	if actions.Has(ActionWarn) && !(c.Private && actions.Has(ActionDelete)) {
+		msg := &guildrone.MessageCreate{
+			Embeds: []guildrone.ChatEmbed{warnEmbed(c, violations)},
+		}
+		if c.Private {
+			msg.IsPrivate = true
+			msg.ReplyMessageIds = []guildrone.MessageID{c.MessageID}
+		}
+		_, err := s.ChannelMessageCreateComplex(c.ChannelID, msg)
+		fail(ActionWarn, err)
+	}
+
+	if actions.Has(ActionTimeout) && timeoutRole != 0 {
+		err := s.ServerMemberRoleAdd(c.ServerID, c.AuthorID, timeoutRole)
+		fail(ActionTimeout, err)
+		if err == nil {
+			serverID, userID := c.ServerID, c.AuthorID
+			time.AfterFunc(timeout, func() {
+				_ = s.ServerMemberRoleRemove(serverID, userID, timeoutRole)
+			})
+		}
+	}
+
+	if actions.Has(ActionKick) {
+		fail(ActionKick, s.ServerMemberKick(c.ServerID, c.AuthorID))
+	}
+
+	if actions.Has(ActionBan) {
+		_, err := s.ServerMemberBanCreate(c.ServerID, c.AuthorID, banReason)
+		fail(ActionBan, err)
+	}
+
+	e.RLock()
+	modLog := e.ModLogChannelID
+	e.RUnlock()
+	if modLog == "" {
+		return
+	}
+	_, _ = s.ChannelMessageCreateComplex(modLog, &guildrone.MessageCreate{
+		Embeds: []guildrone.ChatEmbed{logEmbed(c, violations, actions, errs)},
+	})
+}
+
+// warnEmbed returns the warning sent to the channel of the content.
+func warnEmbed(c *Content, violations []Violation) guildrone.ChatEmbed {
+	reasons := make([]string, len(violations))
+	for i, v := range violations {
+		reasons[i] = v.Reason
+	}
+	return guildrone.ChatEmbed{
+		Title:       "Warning",
+		Description: fmt.Sprintf("<@%s> your post broke the server rules: %s.", c.AuthorID, strings.Join(reasons, "; ")),
+		Color:       embedutil.ColorWarning,
+	}
+}
+
+// logEmbed returns the mod-log entry of the actions taken on the content.
+func logEmbed(c *Content, violations []Violation, actions Action, errs []string) guildrone.ChatEmbed {
+	color := embedutil.ColorInfo
+	switch {
+	case actions&(ActionKick|ActionBan) != 0:
+		color = embedutil.ColorDanger
+	case actions&(ActionWarn|ActionTimeout) != 0:
+		color = embedutil.ColorWarning
+	}
+
+	rules := make([]string, len(violations))
+	for i, v := range violations {
+		rules[i] = v.Rule + ": " + v.Reason
+	}
+
+	fields := []guildrone.ChatEmbedField{
+		{Name: "User", Value: "<@" + string(c.AuthorID) + ">", Inline: true},
+		{Name: "Channel", Value: string(c.ChannelID), Inline: true},
+		{Name: "Actions", Value: actions.String(), Inline: true},
+		{Name: "Rules", Value: embedutil.Truncate(strings.Join(rules, "\n"), embedutil.MaxFieldValue)},
+	}
+	if c.Text != "" {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Content", Value: embedutil.Truncate(c.Text, embedutil.MaxFieldValue)})
+	}
+	if len(errs) > 0 {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Errors", Value: embedutil.Truncate(strings.Join(errs, "\n"), embedutil.MaxFieldValue)})
+	}
+
+	now := time.Now().UTC()
+	return guildrone.ChatEmbed{
+		Title:     "AutoMod",
+		Color:     color,
+		Fields:    fields,
+		Timestamp: &now,
+	}
+}
@@ -0,0 +1,73 @@
+package automod
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// recordingTransport records the requests sent and answers them with an
+// empty object.
+type recordingTransport struct {
+	requests []string
+	messages []guildrone.MessageCreate
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	if req.Method == "POST" && req.Body != nil {
+		var m guildrone.MessageCreate
+		if err := json.NewDecoder(req.Body).Decode(&m); err != nil {
+			return nil, err
+		}
+		r.messages = append(r.messages, m)
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+func TestActWarn(t *testing.T) {
+	tests := []struct {
+		name    string
+		private bool
+		actions Action
+		want    []guildrone.MessageCreate
+	}{
+		{"public", false, ActionWarn, []guildrone.MessageCreate{{}}},
+		{"public deleted", false, ActionWarn | ActionDelete, []guildrone.MessageCreate{{}}},
+		{"private", true, ActionWarn, []guildrone.MessageCreate{{IsPrivate: true, ReplyMessageIds: []guildrone.MessageID{"m1"}}}},
+		{"private deleted", true, ActionWarn | ActionDelete, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &recordingTransport{}
+			e := New(&guildrone.Session{Client: &http.Client{Transport: transport}})
+			c := &Content{ServerID: "s1", ChannelID: "c1", AuthorID: "u1", MessageID: "m1", Private: tt.private}
+
+			e.act(c, []Violation{{Rule: "Words", Reason: "contains a blocked word", Actions: tt.actions}})
+
+			var got []guildrone.MessageCreate
+			for _, m := range transport.messages {
+				if len(m.Embeds) != 1 || m.Embeds[0].Title != "Warning" {
+					t.Fatalf("act() posted %+v, want only warnings", m)
+				}
+				m.Embeds = nil
+				got = append(got, m)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("act() warnings = %+v, want %+v (requests %v)", got, tt.want, transport.requests)
+			}
+		})
+	}
+}
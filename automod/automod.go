@@ -0,0 +1,293 @@
+// Package automod evaluates chat messages and forum topics against
+// moderation rules and acts on the ones that break them.
+//
+// An Engine is created for a Session, given rules with AddRule and
+// attached to the Session's events with Start:
+//
+//	mod := automod.New(s)
+//	mod.ModLogChannelID = "..."
+//	mod.AddRule(automod.NewWordListRule("spam"), automod.ActionDelete|automod.ActionWarn)
+//	mod.AddRule(&automod.MentionSpamRule{MaxUsers: 5}, automod.ActionDelete|automod.ActionKick)
+//	defer mod.Start()()
+package automod
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Action is a set of actions taken on content that breaks a rule.
+type Action int
+
+// Actions an Engine can take.
+const (
+	// Delete the message or forum topic.
+	ActionDelete Action = 1 << iota
+	// Reply to the author with a warning.
+	ActionWarn
+	// Give the author Engine.TimeoutRoleID for Engine.TimeoutDuration.
+	ActionTimeout
+	// Kick the author from the server.
+	ActionKick
+	// Ban the author from the server.
+	ActionBan
+)
+
+var actionNames = []struct {
+	action Action
+	name   string
+}{
+	{ActionDelete, "delete"},
+	{ActionWarn, "warn"},
+	{ActionTimeout, "timeout"},
+	{ActionKick, "kick"},
+	{ActionBan, "ban"},
+}
+
+// Has returns true if the set contains all the actions of action.
+func (a Action) Has(action Action) bool {
+	return a&action == action
+}
+
+// String returns the names of the actions in the set.
+func (a Action) String() string {
+	var names []string
+	for _, n := range actionNames {
+		if a.Has(n.action) {
+			names = append(names, n.name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// Content is a chat message or forum topic checked by the rules.
+type Content struct {
+	ServerID  guildrone.ServerID
+	ChannelID guildrone.ChannelID
+	AuthorID  guildrone.UserID
+
+	// ID of the chat message, empty for forum topics
+	MessageID guildrone.MessageID
+
+	// ID of the forum topic, zero for chat messages
+	TopicID guildrone.ForumTopicID
+
+	// Message content, or topic title and content separated by a new line
+	Text     string
+	Mentions *guildrone.Mentions
+
+	CreatedAt time.Time
+
+	// Whether the content is an edit of a chat message
+	Updated bool
+
+	// Whether the content is a private chat message
+	Private bool
+
+	engine   *Engine
+	memberMu sync.Mutex
+	member   *guildrone.ServerMember
+	err      error
+}
+
+// Member returns the server member of the author.
+// It is looked up in the State, or fetched once if not cached.
+func (c *Content) Member() (*guildrone.ServerMember, error) {
+	c.memberMu.Lock()
+	defer c.memberMu.Unlock()
+
+	if c.member == nil && c.err == nil {
+		c.member, c.err = c.engine.member(c.ServerID, c.AuthorID)
+	}
+	return c.member, c.err
+}
+
+// Violation is a rule broken by a piece of content.
+type Violation struct {
+	Rule    string
+	Reason  string
+	Actions Action
+}
+
+type policy struct {
+	rule    Rule
+	actions Action
+}
+
+// Engine checks content against rules and acts on violations.
+type Engine struct {
+	sync.RWMutex
+
+	session  *guildrone.Session
+	policies []policy
+
+	// Channel the actions taken are logged to, not logged if empty.
+	ModLogChannelID guildrone.ChannelID
+
+	// Role given to members by ActionTimeout, and for how long.
+	// Roles given are not removed if the process stops before the timeout ends.
+	TimeoutRoleID   guildrone.RoleID
+	TimeoutDuration time.Duration
+
+	// Members with any of these roles are not checked.
+	ExemptRoles []guildrone.RoleID
+
+	// Reason used for bans.
+	BanReason string
+}
+
+// New creates an Engine acting through the session.
+func New(s *guildrone.Session) *Engine {
+	return &Engine{
+		session:         s,
+		TimeoutDuration: 10 * time.Minute,
+		BanReason:       "Banned by automod",
+	}
+}
+
+// AddRule adds a rule and the actions taken on content breaking it.
+func (e *Engine) AddRule(rule Rule, actions Action) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.policies = append(e.policies, policy{rule: rule, actions: actions})
+}
+
+// Start adds the event handlers of the engine to the session.
+// It returns a function removing them.
+func (e *Engine) Start() func() {
+	removers := []func(){
+		e.session.AddHandler(e.onChatMessageCreated),
+		e.session.AddHandler(e.onChatMessageUpdated),
+		e.session.AddHandler(e.onForumTopicCreated),
+	}
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+	}
+}
+
+func (e *Engine) onChatMessageCreated(s *guildrone.Session, m *guildrone.ChatMessageCreated) {
+	e.handleMessage(&m.Message, false)
+}
+
+func (e *Engine) onChatMessageUpdated(s *guildrone.Session, m *guildrone.ChatMessageUpdated) {
+	e.handleMessage(&m.Message, true)
+}
+
+func (e *Engine) onForumTopicCreated(s *guildrone.Session, t *guildrone.ForumTopicCreated) {
+	if t.ForumTopic.CreatedByWebhookId != "" {
+		return
+	}
+	e.Handle(&Content{
+		ServerID:  t.ForumTopic.ServerID,
+		ChannelID: t.ForumTopic.ChannelID,
+		AuthorID:  t.ForumTopic.CreatedBy,
+		TopicID:   t.ForumTopic.ID,
+		Text:      t.ForumTopic.Title + "\n" + t.ForumTopic.Content,
+		Mentions:  t.ForumTopic.Mentions,
+		CreatedAt: t.ForumTopic.CreatedAt,
+	})
+}
+
+func (e *Engine) handleMessage(m *guildrone.ChatMessage, updated bool) {
+	if m.CreatedByWebhookId != "" || m.Type == guildrone.MessageTypeSystem {
+		return
+	}
+	e.Handle(&Content{
+		ServerID:  m.ServerID,
+		ChannelID: m.ChannelID,
+		AuthorID:  m.CreatedBy,
+		MessageID: m.ID,
+		Text:      m.Content,
+		Mentions:  m.Mentions,
+		CreatedAt: m.CreatedAt,
+		Updated:   updated,
+		Private:   m.IsPrivate,
+	})
+}
+
+// Handle checks the content and acts on the violations found.
+// Content posted by the bot itself or by exempt members is not checked.
+func (e *Engine) Handle(c *Content) {
+	c.engine = e
+
+	if e.own(c.AuthorID) || c.ServerID == "" || e.exempt(c) {
+		return
+	}
+
+	violations := e.Check(c)
+	if len(violations) == 0 {
+		return
+	}
+	e.act(c, violations)
+}
+
+// Check returns the rules the content breaks without acting on them.
+func (e *Engine) Check(c *Content) []Violation {
+	if c.engine == nil {
+		c.engine = e
+	}
+
+	e.RLock()
+	policies := e.policies
+	e.RUnlock()
+
+	var violations []Violation
+	for _, p := range policies {
+		if reason, ok := p.rule.Check(c); ok {
+			violations = append(violations, Violation{
+				Rule:    p.rule.Name(),
+				Reason:  reason,
+				Actions: p.actions,
+			})
+		}
+	}
+	return violations
+}
+
+// own reports whether the user is the bot the session is logged in as.
+func (e *Engine) own(userID guildrone.UserID) bool {
+	u := e.session.CurrentUser()
+	return u != nil && u.ID == userID
+}
+
+func (e *Engine) exempt(c *Content) bool {
+	e.RLock()
+	exempt := e.ExemptRoles
+	e.RUnlock()
+	if len(exempt) == 0 {
+		return false
+	}
+
+	m, err := c.Member()
+	if err != nil {
+		return false
+	}
+	for _, r := range exempt {
+		for _, mr := range m.RoleIds {
+			if r == mr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// member returns a member from the session State, or fetches it.
+func (e *Engine) member(serverID guildrone.ServerID, userID guildrone.UserID) (*guildrone.ServerMember, error) {
+	s := e.session
+	if s.StateEnabled && s.State != nil {
+		if m, err := s.State.Member(serverID, userID); err == nil {
+			return m, nil
+		}
+	}
+	return s.ServerMemberGet(serverID, userID)
+}
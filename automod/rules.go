@@ -0,0 +1,253 @@
+package automod
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Rule is a check run against every piece of content seen by an Engine.
+type Rule interface {
+	// Name identifies the rule in the mod-log.
+	Name() string
+
+	// Check returns the reason the content breaks the rule,
+	// or false if it does not.
+	Check(c *Content) (string, bool)
+}
+
+// RegexRule matches content against regular expressions.
+type RegexRule struct {
+	Patterns []*regexp.Regexp
+}
+
+// NewRegexRule compiles the patterns into a RegexRule.
+func NewRegexRule(patterns ...string) (*RegexRule, error) {
+	r := &RegexRule{}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		r.Patterns = append(r.Patterns, re)
+	}
+	return r, nil
+}
+
+// Name returns the name of the rule.
+func (r *RegexRule) Name() string { return "regex" }
+
+// Check implements Rule.
+func (r *RegexRule) Check(c *Content) (string, bool) {
+	for _, re := range r.Patterns {
+		if re.MatchString(c.Text) {
+			return fmt.Sprintf("matches pattern `%s`", re.String()), true
+		}
+	}
+	return "", false
+}
+
+// WordListRule matches content containing any of a list of words.
+// Words are compared case-insensitively and only match whole words.
+type WordListRule struct {
+	words map[string]bool
+}
+
+// NewWordListRule creates a WordListRule for the words.
+func NewWordListRule(words ...string) *WordListRule {
+	r := &WordListRule{words: make(map[string]bool, len(words))}
+	for _, w := range words {
+		r.words[strings.ToLower(w)] = true
+	}
+	return r
+}
+
+// Name returns the name of the rule.
+func (r *WordListRule) Name() string { return "word list" }
+
+// Check implements Rule.
+func (r *WordListRule) Check(c *Content) (string, bool) {
+	fields := strings.FieldsFunc(strings.ToLower(c.Text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, f := range fields {
+		if r.words[f] {
+			return fmt.Sprintf("contains the word %q", f), true
+		}
+	}
+	return "", false
+}
+
+// MentionSpamRule matches content mentioning too many users.
+type MentionSpamRule struct {
+	// Maximum number of users mentioned at once.
+	MaxUsers int
+
+	// Whether @everyone and @here mentions are allowed.
+	AllowEveryone bool
+}
+
+// Name returns the name of the rule.
+func (r *MentionSpamRule) Name() string { return "mention spam" }
+
+// Check implements Rule.
+func (r *MentionSpamRule) Check(c *Content) (string, bool) {
+	if c.Mentions == nil {
+		return "", false
+	}
+
+	if !r.AllowEveryone && (c.Mentions.Everyone || c.Mentions.Here) {
+		return "mentions everyone", true
+	}
+
+	users := make(map[guildrone.UserID]bool, len(c.Mentions.Users))
+	for _, u := range c.Mentions.Users {
+		users[u.ID] = true
+	}
+	if len(users) > r.MaxUsers {
+		return fmt.Sprintf("mentions %d users, the limit is %d", len(users), r.MaxUsers), true
+	}
+	return "", false
+}
+
+var linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>()\[\]]+`)
+
+// LinkAllowlistRule matches content linking to domains not in the allowlist.
+// Subdomains of an allowed domain are allowed too.
+type LinkAllowlistRule struct {
+	Domains []string
+}
+
+// Name returns the name of the rule.
+func (r *LinkAllowlistRule) Name() string { return "link allowlist" }
+
+// Check implements Rule.
+func (r *LinkAllowlistRule) Check(c *Content) (string, bool) {
+	for _, link := range linkRegexp.FindAllString(c.Text, -1) {
+		raw := link
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil || !r.allowed(u.Hostname()) {
+			return fmt.Sprintf("links to %s", link), true
+		}
+	}
+	return "", false
+}
+
+func (r *LinkAllowlistRule) allowed(host string) bool {
+	host = strings.ToLower(host)
+	for _, d := range r.Domains {
+		d = strings.ToLower(d)
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// DuplicateRule matches a user repeating the same content in a server.
+// Edits are not counted.
+type DuplicateRule struct {
+	// Number of identical posts allowed within Window.
+	Max int
+
+	// Time span posts are counted over.
+	Window time.Duration
+
+	mu    sync.Mutex
+	posts map[duplicateKey][]time.Time
+}
+
+type duplicateKey struct {
+	serverID guildrone.ServerID
+	userID   guildrone.UserID
+	text     string
+}
+
+// Name returns the name of the rule.
+func (r *DuplicateRule) Name() string { return "duplicate flood" }
+
+// Check implements Rule.
+func (r *DuplicateRule) Check(c *Content) (string, bool) {
+	text := strings.ToLower(strings.Join(strings.Fields(c.Text), " "))
+	if c.Updated || text == "" {
+		return "", false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.posts == nil {
+		r.posts = map[duplicateKey][]time.Time{}
+	}
+
+	now := c.CreatedAt
+	if now.IsZero() {
+		now = time.Now()
+	}
+	r.prune(now)
+
+	key := duplicateKey{c.ServerID, c.AuthorID, text}
+	r.posts[key] = append(r.posts[key], now)
+
+	if n := len(r.posts[key]); n > r.Max {
+		return fmt.Sprintf("posted the same content %d times within %s", n, r.Window), true
+	}
+	return "", false
+}
+
+// prune drops the posts older than the window.
+func (r *DuplicateRule) prune(now time.Time) {
+	for key, times := range r.posts {
+		i := 0
+		for i < len(times) && now.Sub(times[i]) > r.Window {
+			i++
+		}
+		if i == len(times) {
+			delete(r.posts, key)
+		} else {
+			r.posts[key] = times[i:]
+		}
+	}
+}
+
+// AccountAgeRule matches content posted by accounts younger than MinAge.
+type AccountAgeRule struct {
+	MinAge time.Duration
+}
+
+// Name returns the name of the rule.
+func (r *AccountAgeRule) Name() string { return "account age" }
+
+// Check implements Rule.
+// The content does not break the rule if the author could not be fetched
+// or the creation date of the account is unknown.
+func (r *AccountAgeRule) Check(c *Content) (string, bool) {
+	m, err := c.Member()
+	if err != nil {
+		return "", false
+	}
+	if m.User.CreatedAt.IsZero() {
+		// Members cached from a member list have no creation date.
+		if m, err = c.engine.session.ServerMemberGet(c.ServerID, c.AuthorID); err != nil || m.User.CreatedAt.IsZero() {
+			return "", false
+		}
+	}
+
+	now := c.CreatedAt
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if age := now.Sub(m.User.CreatedAt); age < r.MinAge {
+		return fmt.Sprintf("account is %s old, the minimum is %s", age.Round(time.Minute), r.MinAge), true
+	}
+	return "", false
+}
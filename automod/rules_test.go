@@ -0,0 +1,129 @@
+package automod
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+func TestRules(t *testing.T) {
+	regex, err := NewRegexRule(`(?i)free\s+nitro`, `\d{16}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := NewWordListRule("Spam", "scam")
+	mentions := &MentionSpamRule{MaxUsers: 2}
+	links := &LinkAllowlistRule{Domains: []string{"guilded.gg"}}
+
+	users := func(ids ...guildrone.UserID) *guildrone.Mentions {
+		m := &guildrone.Mentions{}
+		for _, id := range ids {
+			m.Users = append(m.Users, guildrone.MentionUser{ID: id})
+		}
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		content *Content
+		want    bool
+	}{
+		{"regex match", regex, &Content{Text: "get FREE  nitro now"}, true},
+		{"regex second pattern", regex, &Content{Text: "card 1234567812345678"}, true},
+		{"regex no match", regex, &Content{Text: "nitro is not free"}, false},
+		{"word", words, &Content{Text: "this is SPAM!"}, true},
+		{"word in another word", words, &Content{Text: "scammer"}, false},
+		{"no word", words, &Content{Text: "hello"}, false},
+		{"mentions under limit", mentions, &Content{Mentions: users("a", "b")}, false},
+		{"mentions over limit", mentions, &Content{Mentions: users("a", "b", "c")}, true},
+		{"repeated mentions counted once", mentions, &Content{Mentions: users("a", "a", "b", "b")}, false},
+		{"everyone", mentions, &Content{Mentions: &guildrone.Mentions{Everyone: true}}, true},
+		{"everyone allowed", &MentionSpamRule{MaxUsers: 2, AllowEveryone: true}, &Content{Mentions: &guildrone.Mentions{Here: true}}, false},
+		{"no mentions", mentions, &Content{}, false},
+		{"allowed link", links, &Content{Text: "see https://www.guilded.gg/docs"}, false},
+		{"allowed domain", links, &Content{Text: "see https://guilded.gg"}, false},
+		{"other link", links, &Content{Text: "see https://example.com/guilded.gg"}, true},
+		{"suffix is not a subdomain", links, &Content{Text: "www.notguilded.gg"}, true},
+		{"no link", links, &Content{Text: "guilded dot gg"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, got := tt.rule.Check(tt.content)
+			if got != tt.want {
+				t.Errorf("%s.Check(%q) = %v (%s), want %v", tt.rule.Name(), tt.content.Text, got, reason, tt.want)
+			}
+			if got && reason == "" {
+				t.Errorf("%s.Check(%q) matched without a reason", tt.rule.Name(), tt.content.Text)
+			}
+		})
+	}
+}
+
+func TestDuplicateRule(t *testing.T) {
+	r := &DuplicateRule{Max: 2, Window: time.Minute}
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		content *Content
+		want    bool
+	}{
+		{"first", &Content{ServerID: "s", AuthorID: "u", Text: "hi", CreatedAt: at}, false},
+		{"second", &Content{ServerID: "s", AuthorID: "u", Text: " HI ", CreatedAt: at.Add(time.Second)}, false},
+		{"edit not counted", &Content{ServerID: "s", AuthorID: "u", Text: "hi", CreatedAt: at.Add(2 * time.Second), Updated: true}, false},
+		{"other user", &Content{ServerID: "s", AuthorID: "v", Text: "hi", CreatedAt: at.Add(3 * time.Second)}, false},
+		{"third", &Content{ServerID: "s", AuthorID: "u", Text: "hi", CreatedAt: at.Add(4 * time.Second)}, true},
+		{"after the window", &Content{ServerID: "s", AuthorID: "u", Text: "hi", CreatedAt: at.Add(2 * time.Minute)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := r.Check(tt.content); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("no network in tests")
+}
+
+func TestAccountAgeRule(t *testing.T) {
+	s := &guildrone.Session{Client: &http.Client{Transport: failingTransport{}}}
+	e := New(s)
+	r := &AccountAgeRule{MinAge: 24 * time.Hour}
+	at := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		createdAt time.Time
+		want      bool
+	}{
+		{"new account", at.Add(-time.Hour), true},
+		{"old account", at.Add(-48 * time.Hour), false},
+		{"unknown creation date", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Content{
+				ServerID:  "s",
+				AuthorID:  "u",
+				CreatedAt: at,
+				engine:    e,
+				member:    &guildrone.ServerMember{User: guildrone.User{ID: "u", CreatedAt: tt.createdAt}},
+			}
+			if _, got := r.Check(c); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
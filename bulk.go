@@ -0,0 +1,237 @@
+package guildrone
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is the number of requests a bulk operation
+// keeps in flight when BulkOptions.Concurrency is not set.
+const DefaultBulkConcurrency = 4
+
+const (
+	// Number of times a rate limited request of a bulk operation is sent
+	// before its RateLimitError is returned.
+	bulkMaxAttempts = 5
+
+	// Shortest pause after a rate limit, Guilded may answer without a delay.
+	bulkMinRetryDelay = 500 * time.Millisecond
+)
+
+// BulkOptions configures a bulk moderation operation.
+type BulkOptions struct {
+	// Maximum number of requests in flight at once.
+	// DefaultBulkConcurrency is used when zero or negative.
+	Concurrency int
+
+	// Report the users the operation would apply to without
+	// sending any requests.
+	DryRun bool
+}
+
+// BulkResult is the outcome of a bulk operation for a single user.
+type BulkResult struct {
+	UserID UserID
+
+	// Err is the error of the request for the user, nil on success.
+	Err error
+
+	// DryRun is true when no request was sent for the user.
+	DryRun bool
+}
+
+// BulkResults are the per-user results of a bulk operation,
+// in the order the users were given.
+type BulkResults []BulkResult
+
+// Failed returns the results with an error.
+func (r BulkResults) Failed() BulkResults {
+	var failed BulkResults
+	for _, res := range r {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Succeeded returns the IDs of the users the operation was applied to.
+func (r BulkResults) Succeeded() []UserID {
+	var ids []UserID
+	for _, res := range r {
+		if res.Err == nil && !res.DryRun {
+			ids = append(ids, res.UserID)
+		}
+	}
+	return ids
+}
+
+// Bulk operations stop when ctx is done, the users not processed yet
+// get the error of the context. Rate limited requests are not retried by
+// RequestCall, whatever ShouldRetryOnRateLimit is, all the workers pause
+// together instead.
+
+// ServerMembersBanBulk bans several members of a server.
+// ctx      : Stops the operation when done.
+// serverID : The ID of a Server.
+// userIDs  : The IDs of the Users to ban.
+// reason   : The reason for the bans.
+// opts     : Bulk options, may be nil.
+func (s *Session) ServerMembersBanBulk(ctx context.Context, serverID ServerID, userIDs []UserID, reason string, opts *BulkOptions) BulkResults {
+	return s.bulk(ctx, userIDs, opts, func(userID UserID) error {
+		return s.bulkRequest(ctx, "POST", EndpointServerBansMember(serverID, userID), &ServerMemberBanCreate{
+			Reason: reason,
+		})
+	})
+}
+
+// ServerMembersKickBulk kicks several members from a server.
+// ctx      : Stops the operation when done.
+// serverID : The ID of a Server.
+// userIDs  : The IDs of the Users to kick.
+// opts     : Bulk options, may be nil.
+func (s *Session) ServerMembersKickBulk(ctx context.Context, serverID ServerID, userIDs []UserID, opts *BulkOptions) BulkResults {
+	return s.bulk(ctx, userIDs, opts, func(userID UserID) error {
+		return s.bulkRequest(ctx, "DELETE", EndpointServerMember(serverID, userID), nil)
+	})
+}
+
+// ServerMembersRoleAddBulk adds a role to several members of a server.
+// ctx      : Stops the operation when done.
+// serverID : The ID of a Server.
+// userIDs  : The IDs of the Members.
+// roleID   : The ID of a Role.
+// opts     : Bulk options, may be nil.
+func (s *Session) ServerMembersRoleAddBulk(ctx context.Context, serverID ServerID, userIDs []UserID, roleID RoleID, opts *BulkOptions) BulkResults {
+	return s.bulk(ctx, userIDs, opts, func(userID UserID) error {
+		return s.bulkRequest(ctx, "PUT", EndpointServerMemberRole(serverID, userID, roleID), nil)
+	})
+}
+
+// bulkRequest sends a request of a bulk operation, returning a
+// RateLimitError when rate limited so that bulkGate retries it.
+func (s *Session) bulkRequest(ctx context.Context, method, urlStr string, data interface{}) error {
+	var body []byte
+	if data != nil {
+		var err error
+		body, err = Marshal(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := s.requestCall(ctx, method, urlStr, "application/json", body, 0, false)
+	return err
+}
+
+// bulk calls do once for every distinct user ID with at most
+// opts.Concurrency calls running at once.
+func (s *Session) bulk(ctx context.Context, userIDs []UserID, opts *BulkOptions, do func(UserID) error) BulkResults {
+	var o BulkOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultBulkConcurrency
+	}
+
+	seen := make(map[UserID]bool, len(userIDs))
+	results := make(BulkResults, 0, len(userIDs))
+	for _, id := range userIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		results = append(results, BulkResult{UserID: id, DryRun: o.DryRun})
+	}
+
+	if o.DryRun {
+		return results
+	}
+
+	var (
+		wg    sync.WaitGroup
+		gate  bulkGate
+		queue = make(chan int)
+	)
+	for w := 0; w < o.Concurrency && w < len(results); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				results[i].Err = gate.do(ctx, func() error {
+					return do(results[i].UserID)
+				})
+			}
+		}()
+	}
+
+	for i := range results {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	return results
+}
+
+// bulkGate pauses all the workers of a bulk operation while one of
+// them is rate limited, so the other requests do not hit the limit too.
+type bulkGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// do waits until the gate is open and calls f, retrying it after
+// the requested delay while it returns a RateLimitError, at most
+// bulkMaxAttempts times. It returns the error of ctx once it is done.
+func (g *bulkGate) do(ctx context.Context, f func() error) error {
+	var err error
+	for attempt := 0; attempt < bulkMaxAttempts; attempt++ {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+
+		err = f()
+		var rl *RateLimitError
+		if !errors.As(err, &rl) {
+			return err
+		}
+		g.close(rl.RetryAfter)
+	}
+	return err
+}
+
+func (g *bulkGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	d := time.Until(g.until)
+	g.mu.Unlock()
+
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (g *bulkGate) close(d time.Duration) {
+	if d < bulkMinRetryDelay {
+		d = bulkMinRetryDelay
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
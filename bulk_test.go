@@ -0,0 +1,113 @@
+package guildrone
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBulkDedupesAndDryRuns(t *testing.T) {
+	s := &Session{}
+	results := s.bulk(context.Background(), []UserID{"a", "b", "a", "c"}, &BulkOptions{DryRun: true}, func(UserID) error {
+		t.Fatal("request sent during a dry run")
+		return nil
+	})
+
+	var ids []UserID
+	for _, r := range results {
+		ids = append(ids, r.UserID)
+		if !r.DryRun {
+			t.Errorf("%s: DryRun = false", r.UserID)
+		}
+	}
+	if want := []UserID{"a", "b", "c"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("bulk() users = %v, want %v", ids, want)
+	}
+	if got := results.Succeeded(); len(got) != 0 {
+		t.Errorf("Succeeded() = %v, want none", got)
+	}
+}
+
+func TestBulkResults(t *testing.T) {
+	fail := errors.New("fail")
+	s := &Session{}
+	results := s.bulk(context.Background(), []UserID{"a", "b", "c"}, nil, func(id UserID) error {
+		if id == "b" {
+			return fail
+		}
+		return nil
+	})
+
+	if got, want := results.Succeeded(), []UserID{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Succeeded() = %v, want %v", got, want)
+	}
+	if failed := results.Failed(); len(failed) != 1 || failed[0].UserID != "b" || failed[0].Err != fail {
+		t.Errorf("Failed() = %v, want b", failed)
+	}
+}
+
+func TestBulkGateRateLimited(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	var g bulkGate
+	calls := 0
+	err := g.do(ctx, func() error {
+		calls++
+		return &RateLimitError{&RateLimit{}}
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("do() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if calls != 1 {
+		t.Errorf("do() called f %d times before the minimum retry delay, want 1", calls)
+	}
+}
+
+func TestBulkCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := &Session{}
+	results := s.bulk(ctx, []UserID{"a", "b"}, nil, func(UserID) error {
+		t.Error("request sent after cancellation")
+		return nil
+	})
+	for _, r := range results {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("%s: Err = %v, want %v", r.UserID, r.Err, context.Canceled)
+		}
+	}
+}
+
+func TestBulkRequestNotRetried(t *testing.T) {
+	calls := 0
+	s := &Session{
+		ShouldRetryOnRateLimit: true,
+		Client: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				Status:     "429 Too Many Requests",
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"0"}},
+				Body:       io.NopCloser(strings.NewReader("")),
+				Request:    req,
+			}, nil
+		})},
+	}
+
+	err := s.bulkRequest(context.Background(), "DELETE", EndpointServerMember("s1", "u1"), nil)
+	var rl *RateLimitError
+	if !errors.As(err, &rl) {
+		t.Errorf("bulkRequest() error = %v, want a RateLimitError", err)
+	}
+	if calls != 1 {
+		t.Errorf("bulkRequest() sent %d requests, want 1", calls)
+	}
+}
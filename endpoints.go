@@ -6,43 +6,167 @@ var (
 	EndpointGuildedWebsocket = "wss://www.guilded.gg/websocket/v1"
 
 	EndpointGuilded  = "https://www.guilded.gg/"
+	EndpointMedia    = "https://media.guilded.gg/"
 	EndpointAPI      = EndpointGuilded + "api/v" + APIVersion + "/"
 	EndpointChannels = EndpointAPI + "channels/"
 	EndpointServers  = EndpointAPI + "servers/"
 	EndpointGroups   = EndpointAPI + "groups/"
+	EndpointUsers    = EndpointAPI + "users/"
 
-	EndpointChannel              = func(cID string) string { return EndpointChannels + cID }
-	EndpointServer               = func(sID string) string { return EndpointServers + sID }
-	EndpointChannelMessages      = func(cID string) string { return EndpointChannels + cID + "/messages" }
-	EndpointChannelMessage       = func(cID, mID string) string { return EndpointChannels + cID + "/messages/" + mID }
-	EndpointServerMembers        = func(sID string) string { return EndpointServers + sID + "/members" }
-	EndpointServerMember         = func(sID, uID string) string { return EndpointServers + sID + "/members/" + uID }
-	EndpointServerMemberNickname = func(sID, uID string) string { return EndpointServers + sID + "/members/" + uID + "/nickname" }
-	EndpointServerBans           = func(sID string) string { return EndpointServers + sID + "/bans" }
-	EndpointServerBansMember     = func(sID, uID string) string { return EndpointServers + sID + "/bans/" + uID }
-	EndpointChannelTopics        = func(cID string) string { return EndpointChannels + cID + "/topics" }
-	EndpointChannelTopic         = func(cID, tID string) string { return EndpointChannels + cID + "/topics/" + tID }
-	EndpointChannelTopicPin      = func(cID, tID string) string { return EndpointChannels + cID + "/topics/" + tID + "/pin" }
-	EndpointChannelItems         = func(cID string) string { return EndpointChannels + cID + "/items" }
-	EndpointChannelItem          = func(cID, iID string) string { return EndpointChannels + cID + "/items/" + iID }
-	EndpointChannelItemComplete  = func(cID, iID string) string { return EndpointChannels + cID + "/items/" + iID + "/complete" }
-	EndpointChannelDocs          = func(cID string) string { return EndpointChannels + cID + "/docs" }
-	EndpointChannelDoc           = func(cID, dID string) string { return EndpointChannels + cID + "/docs/" + dID }
-	EndpointChannelEvents        = func(cID string) string { return EndpointChannels + cID + "/events" }
-	EndpointChannelEvent         = func(cID, eID string) string { return EndpointChannels + cID + "/events/" + eID }
-	EndpointChannelEventRsvps    = func(cID, eID string) string { return EndpointChannels + cID + "/events/" + eID + "/rsvps" }
-	EndpointChannelEventRsvp     = func(cID, eID, uID string) string { return EndpointChannels + cID + "/events/" + eID + "/rsvps/" + uID }
-	EndpointChannelReaction      = func(cID, coID, eID string) string {
-		return EndpointChannels + cID + "/content/" + coID + "/emotes/" + eID
-	}
-	EndpointServerXPMember         = func(sID, uID string) string { return EndpointServers + sID + "/members/" + uID + "/xp" }
-	EndpointServerXPRoles          = func(sID, rID string) string { return EndpointServers + sID + "/roles/" + rID + "/xp" }
-	EndpointServerMemberSocialLink = func(sID, uID, linkType string) string {
-		return EndpointServers + sID + "/members/" + uID + "/social-links/" + linkType
-	}
-	EndpointGroupMember       = func(gID, uID string) string { return EndpointGroups + gID + "/members/" + uID }
-	EndpointServerMemberRoles = func(sID, uID string) string { return EndpointServers + sID + "/members/" + uID + "/roles" }
-	EndpointServerMemberRole  = func(sID, uID, rID string) string { return EndpointServers + sID + "/members/" + uID + "/roles/" + rID }
-	EndpointServerWeebhooks   = func(sID string) string { return EndpointServers + sID + "/webhooks" }
-	EndpointServerWeebhook    = func(sID, wID string) string { return EndpointServers + sID + "/webhooks/" + wID }
+	EndpointChannel         = func(cID ChannelID) string { return EndpointChannels + string(cID) }
+	EndpointChannelArchive  = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/archive" }
+	EndpointServer          = func(sID ServerID) string { return EndpointServers + string(sID) }
+	EndpointChannelMessages = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/messages" }
+	EndpointChannelMessage  = func(cID ChannelID, mID MessageID) string {
+		return EndpointChannels + string(cID) + "/messages/" + string(mID)
+	}
+	EndpointServerChannelPermissions = func(sID ServerID, cID ChannelID, kind string) string {
+		return EndpointServers + string(sID) + "/channels/" + string(cID) + "/permissions/" + kind
+	}
+	EndpointServerChannelPermission = func(sID ServerID, cID ChannelID, kind, id string) string {
+		return EndpointServers + string(sID) + "/channels/" + string(cID) + "/permissions/" + kind + "/" + id
+	}
+	EndpointServerCategoryPermissions = func(sID ServerID, caID CategoryID, kind string) string {
+		return EndpointServers + string(sID) + "/categories/" + caID.String() + "/permissions/" + kind
+	}
+	EndpointServerCategoryPermission = func(sID ServerID, caID CategoryID, kind, id string) string {
+		return EndpointServers + string(sID) + "/categories/" + caID.String() + "/permissions/" + kind + "/" + id
+	}
+	EndpointServerMembers = func(sID ServerID) string { return EndpointServers + string(sID) + "/members" }
+	EndpointServerMember  = func(sID ServerID, uID UserID) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID)
+	}
+	EndpointServerMemberNickname = func(sID ServerID, uID UserID) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID) + "/nickname"
+	}
+	EndpointServerBans       = func(sID ServerID) string { return EndpointServers + string(sID) + "/bans" }
+	EndpointServerBansMember = func(sID ServerID, uID UserID) string { return EndpointServers + string(sID) + "/bans/" + string(uID) }
+	EndpointChannelTopics    = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/topics" }
+	EndpointChannelTopic     = func(cID ChannelID, tID ForumTopicID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String()
+	}
+	EndpointChannelTopicPin = func(cID ChannelID, tID ForumTopicID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/pin"
+	}
+	EndpointChannelTopicLock = func(cID ChannelID, tID ForumTopicID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/lock"
+	}
+	EndpointChannelTopicComments = func(cID ChannelID, tID ForumTopicID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/comments"
+	}
+	EndpointChannelTopicComment = func(cID ChannelID, tID ForumTopicID, coID ForumTopicCommentID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/comments/" + coID.String()
+	}
+	EndpointChannelItems = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/items" }
+	EndpointChannelItem  = func(cID ChannelID, iID ListItemID) string {
+		return EndpointChannels + string(cID) + "/items/" + string(iID)
+	}
+	EndpointChannelItemComplete = func(cID ChannelID, iID ListItemID) string {
+		return EndpointChannels + string(cID) + "/items/" + string(iID) + "/complete"
+	}
+	EndpointChannelDocs        = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/docs" }
+	EndpointChannelDoc         = func(cID ChannelID, dID DocID) string { return EndpointChannels + string(cID) + "/docs/" + dID.String() }
+	EndpointChannelDocComments = func(cID ChannelID, dID DocID) string {
+		return EndpointChannels + string(cID) + "/docs/" + dID.String() + "/comments"
+	}
+	EndpointChannelDocComment = func(cID ChannelID, dID DocID, coID DocCommentID) string {
+		return EndpointChannels + string(cID) + "/docs/" + dID.String() + "/comments/" + coID.String()
+	}
+	EndpointChannelDocCommentReaction = func(cID ChannelID, dID DocID, coID DocCommentID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/docs/" + dID.String() + "/comments/" + coID.String() + "/emotes/" + eID.String()
+	}
+	EndpointChannelEvents = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/events" }
+	EndpointChannelEvent  = func(cID ChannelID, eID CalendarEventID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String()
+	}
+	EndpointChannelEventRsvps = func(cID ChannelID, eID CalendarEventID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String() + "/rsvps"
+	}
+	EndpointChannelEventRsvp = func(cID ChannelID, eID CalendarEventID, uID UserID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String() + "/rsvps/" + string(uID)
+	}
+	EndpointChannelEventSeries = func(cID ChannelID, sID CalendarEventSeriesID) string {
+		return EndpointChannels + string(cID) + "/event_series/" + string(sID)
+	}
+	EndpointChannelEventComments = func(cID ChannelID, eID CalendarEventID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String() + "/comments"
+	}
+	EndpointChannelEventComment = func(cID ChannelID, eID CalendarEventID, coID CalendarEventCommentID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String() + "/comments/" + coID.String()
+	}
+	EndpointChannelEventCommentReaction = func(cID ChannelID, eID CalendarEventID, coID CalendarEventCommentID, emID EmoteID) string {
+		return EndpointChannels + string(cID) + "/events/" + eID.String() + "/comments/" + coID.String() + "/emotes/" + emID.String()
+	}
+	EndpointChannelReaction = func(cID ChannelID, coID string, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/content/" + coID + "/emotes/" + eID.String()
+	}
+	EndpointChannelMessageReactions = func(cID ChannelID, mID MessageID) string {
+		return EndpointChannels + string(cID) + "/messages/" + string(mID) + "/emotes"
+	}
+	EndpointChannelMessageReaction = func(cID ChannelID, mID MessageID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/messages/" + string(mID) + "/emotes/" + eID.String()
+	}
+	EndpointChannelTopicReaction = func(cID ChannelID, tID ForumTopicID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/emotes/" + eID.String()
+	}
+	EndpointChannelTopicCommentReaction = func(cID ChannelID, tID ForumTopicID, coID ForumTopicCommentID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/topics/" + tID.String() + "/comments/" + coID.String() + "/emotes/" + eID.String()
+	}
+	EndpointChannelDocReaction = func(cID ChannelID, dID DocID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/docs/" + dID.String() + "/emotes/" + eID.String()
+	}
+	EndpointChannelEventReaction = func(cID ChannelID, evID CalendarEventID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/events/" + evID.String() + "/emotes/" + eID.String()
+	}
+	EndpointChannelAnnouncementReaction = func(cID ChannelID, aID AnnouncementID, eID EmoteID) string {
+		return EndpointChannels + string(cID) + "/announcements/" + string(aID) + "/emotes/" + eID.String()
+	}
+	EndpointChannelAnnouncements = func(cID ChannelID) string { return EndpointChannels + string(cID) + "/announcements" }
+	EndpointChannelAnnouncement  = func(cID ChannelID, aID AnnouncementID) string {
+		return EndpointChannels + string(cID) + "/announcements/" + string(aID)
+	}
+	EndpointChannelAnnouncementComments = func(cID ChannelID, aID AnnouncementID) string {
+		return EndpointChannels + string(cID) + "/announcements/" + string(aID) + "/comments"
+	}
+	EndpointChannelAnnouncementComment = func(cID ChannelID, aID AnnouncementID, coID AnnouncementCommentID) string {
+		return EndpointChannels + string(cID) + "/announcements/" + string(aID) + "/comments/" + coID.String()
+	}
+	EndpointServerXPMember = func(sID ServerID, uID UserID) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID) + "/xp"
+	}
+	EndpointServerXPRoles = func(sID ServerID, rID RoleID) string {
+		return EndpointServers + string(sID) + "/roles/" + rID.String() + "/xp"
+	}
+	EndpointServerMemberSocialLink = func(sID ServerID, uID UserID, linkType string) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID) + "/social-links/" + linkType
+	}
+	EndpointServerChannels = func(sID ServerID) string { return EndpointServers + string(sID) + "/channels" }
+	EndpointServerGroups   = func(sID ServerID) string { return EndpointServers + string(sID) + "/groups" }
+	EndpointServerGroup    = func(sID ServerID, gID GroupID) string {
+		return EndpointServers + string(sID) + "/groups/" + string(gID)
+	}
+	EndpointServerCategories = func(sID ServerID) string { return EndpointServers + string(sID) + "/categories" }
+	EndpointServerCategory   = func(sID ServerID, caID CategoryID) string {
+		return EndpointServers + string(sID) + "/categories/" + caID.String()
+	}
+	EndpointGroupMember       = func(gID GroupID, uID UserID) string { return EndpointGroups + string(gID) + "/members/" + string(uID) }
+	EndpointServerRoles       = func(sID ServerID) string { return EndpointServers + string(sID) + "/roles" }
+	EndpointServerRole        = func(sID ServerID, rID RoleID) string { return EndpointServers + string(sID) + "/roles/" + rID.String() }
+	EndpointServerMemberRoles = func(sID ServerID, uID UserID) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID) + "/roles"
+	}
+	EndpointServerMemberRole = func(sID ServerID, uID UserID, rID RoleID) string {
+		return EndpointServers + string(sID) + "/members/" + string(uID) + "/roles/" + rID.String()
+	}
+	EndpointServerWeebhooks = func(sID ServerID) string { return EndpointServers + string(sID) + "/webhooks" }
+	EndpointServerWeebhook  = func(sID ServerID, wID WebhookID) string {
+		return EndpointServers + string(sID) + "/webhooks/" + string(wID)
+	}
+	EndpointUser           = func(uID UserID) string { return EndpointUsers + string(uID) }
+	EndpointUserServers    = func(uID UserID) string { return EndpointUsers + string(uID) + "/servers" }
+	EndpointUserStatus     = func(uID UserID) string { return EndpointUsers + string(uID) + "/status" }
+	EndpointMediaUpload    = func(mediaType string) string { return EndpointMedia + "media/upload?dynamicMediaTypeId=" + mediaType }
+	EndpointWebhookExecute = func(wID WebhookID, token string) string {
+		return EndpointMedia + "webhooks/" + string(wID) + "/" + token
+	}
 )
@@ -217,8 +217,7 @@ func (s *Session) onInterface(i interface{}) {
 	//case *VoiceStateUpdate:
 	//	go s.onVoiceStateUpdate(t)
 	//}
-	//err := s.State.OnInterface(s, i)
-	//if err != nil {
-	//	s.log(LogDebug, "error dispatching internal event, %s", err)
-	//}
+	if s.StateEnabled && s.State != nil {
+		s.State.onInterface(i)
+	}
 }
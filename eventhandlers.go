@@ -7,45 +7,349 @@ package guildrone
 // Event type values are used to match the events returned by Guilded.
 // EventTypes surrounded by __ are synthetic and are internal to Guildrone.
 const (
-	calendarEventCreatedEventType          = "CalendarEventCreated"
-	calendarEventDeletedEventType          = "CalendarEventDeleted"
-	calendarEventRsvpDeletedEventType      = "CalendarEventRsvpDeleted"
-	calendarEventRsvpManyUpdatedEventType  = "CalendarEventRsvpManyUpdated"
-	calendarEventRsvpUpdatedEventType      = "CalendarEventRsvpUpdated"
-	calendarEventUpdatedEventType          = "CalendarEventUpdated"
-	channelMessageReactionCreatedEventType = "ChannelMessageReactionCreated"
-	channelMessageReactionDeletedEventType = "ChannelMessageReactionDeleted"
-	chatMessageCreatedEventType            = "ChatMessageCreated"
-	chatMessageDeletedEventType            = "ChatMessageDeleted"
-	chatMessageUpdatedEventType            = "ChatMessageUpdated"
-	connectEventType                       = "__Connect__"
-	disconnectEventType                    = "__Disconnect__"
-	docCreatedEventType                    = "DocCreated"
-	docDeletedEventType                    = "DocDeleted"
-	docUpdatedEventType                    = "DocUpdated"
-	eventEventType                         = "__Event__"
-	forumTopicCreatedEventType             = "ForumTopicCreated"
-	forumTopicDeletedEventType             = "ForumTopicDeleted"
-	forumTopicUpdatedEventType             = "ForumTopicUpdated"
-	listItemCompletedEventType             = "ListItemCompleted"
-	listItemCreatedEventType               = "ListItemCreated"
-	listItemDeletedEventType               = "ListItemDeleted"
-	listItemUpdatedEventType               = "ListItemUpdated"
-	rateLimitEventType                     = "__RateLimit__"
-	readyEventType                         = "Ready"
-	resumeEventType                        = "__Resume__"
-	teamChannelCreatedEventType            = "TeamChannelCreated"
-	teamChannelUpdatedEventType            = "TeamChannelUpdated"
-	teamMemberBannedEventType              = "TeamMemberBanned"
-	teamMemberJoinedEventType              = "TeamMemberJoined"
-	teamMemberRemovedEventType             = "TeamMemberRemoved"
-	teamMemberUnbannedEventType            = "TeamMemberUnbanned"
-	teamMemberUpdatedEventType             = "TeamMemberUpdated"
-	teamRolesUpdatedEventType              = "TeamRolesUpdated"
-	teamWebhookCreatedEventType            = "TeamWebhookCreated"
-	teamWebhookUpdatedEventType            = "TeamWebhookUpdated"
+	announcementCommentCreatedEventType          = "AnnouncementCommentCreated"
+	announcementCommentDeletedEventType          = "AnnouncementCommentDeleted"
+	announcementCommentUpdatedEventType          = "AnnouncementCommentUpdated"
+	announcementCreatedEventType                 = "AnnouncementCreated"
+	announcementDeletedEventType                 = "AnnouncementDeleted"
+	announcementReactionCreatedEventType         = "AnnouncementReactionCreated"
+	announcementReactionDeletedEventType         = "AnnouncementReactionDeleted"
+	announcementUpdatedEventType                 = "AnnouncementUpdated"
+	calendarEventCommentCreatedEventType         = "CalendarEventCommentCreated"
+	calendarEventCommentDeletedEventType         = "CalendarEventCommentDeleted"
+	calendarEventCommentReactionCreatedEventType = "CalendarEventCommentReactionCreated"
+	calendarEventCommentReactionDeletedEventType = "CalendarEventCommentReactionDeleted"
+	calendarEventCommentUpdatedEventType         = "CalendarEventCommentUpdated"
+	calendarEventCreatedEventType                = "CalendarEventCreated"
+	calendarEventDeletedEventType                = "CalendarEventDeleted"
+	calendarEventReactionCreatedEventType        = "CalendarEventReactionCreated"
+	calendarEventReactionDeletedEventType        = "CalendarEventReactionDeleted"
+	calendarEventRsvpDeletedEventType            = "CalendarEventRsvpDeleted"
+	calendarEventRsvpManyUpdatedEventType        = "CalendarEventRsvpManyUpdated"
+	calendarEventRsvpUpdatedEventType            = "CalendarEventRsvpUpdated"
+	calendarEventSeriesDeletedEventType          = "CalendarEventSeriesDeleted"
+	calendarEventSeriesUpdatedEventType          = "CalendarEventSeriesUpdated"
+	calendarEventUpdatedEventType                = "CalendarEventUpdated"
+	channelMessageReactionCreatedEventType       = "ChannelMessageReactionCreated"
+	channelMessageReactionDeletedEventType       = "ChannelMessageReactionDeleted"
+	channelMessageReactionManyDeletedEventType   = "ChannelMessageReactionManyDeleted"
+	chatMessageCreatedEventType                  = "ChatMessageCreated"
+	chatMessageDeletedEventType                  = "ChatMessageDeleted"
+	chatMessageUpdatedEventType                  = "ChatMessageUpdated"
+	connectEventType                             = "__Connect__"
+	disconnectEventType                          = "__Disconnect__"
+	docCommentCreatedEventType                   = "DocCommentCreated"
+	docCommentDeletedEventType                   = "DocCommentDeleted"
+	docCommentReactionCreatedEventType           = "DocCommentReactionCreated"
+	docCommentReactionDeletedEventType           = "DocCommentReactionDeleted"
+	docCommentUpdatedEventType                   = "DocCommentUpdated"
+	docCreatedEventType                          = "DocCreated"
+	docDeletedEventType                          = "DocDeleted"
+	docReactionCreatedEventType                  = "DocReactionCreated"
+	docReactionDeletedEventType                  = "DocReactionDeleted"
+	docUpdatedEventType                          = "DocUpdated"
+	eventEventType                               = "__Event__"
+	forumTopicCommentCreatedEventType            = "ForumTopicCommentCreated"
+	forumTopicCommentDeletedEventType            = "ForumTopicCommentDeleted"
+	forumTopicCommentReactionCreatedEventType    = "ForumTopicCommentReactionCreated"
+	forumTopicCommentReactionDeletedEventType    = "ForumTopicCommentReactionDeleted"
+	forumTopicCommentUpdatedEventType            = "ForumTopicCommentUpdated"
+	forumTopicCreatedEventType                   = "ForumTopicCreated"
+	forumTopicDeletedEventType                   = "ForumTopicDeleted"
+	forumTopicLockedEventType                    = "ForumTopicLocked"
+	forumTopicPinnedEventType                    = "ForumTopicPinned"
+	forumTopicReactionCreatedEventType           = "ForumTopicReactionCreated"
+	forumTopicReactionDeletedEventType           = "ForumTopicReactionDeleted"
+	forumTopicUnlockedEventType                  = "ForumTopicUnlocked"
+	forumTopicUnpinnedEventType                  = "ForumTopicUnpinned"
+	forumTopicUpdatedEventType                   = "ForumTopicUpdated"
+	gatewayInternalErrorEventType                = "__GatewayInternalError__"
+	gatewayInvalidCursorEventType                = "__GatewayInvalidCursor__"
+	listItemCompletedEventType                   = "ListItemCompleted"
+	listItemCreatedEventType                     = "ListItemCreated"
+	listItemDeletedEventType                     = "ListItemDeleted"
+	listItemUpdatedEventType                     = "ListItemUpdated"
+	rateLimitEventType                           = "__RateLimit__"
+	readyEventType                               = "Ready"
+	reconnectFailedEventType                     = "__ReconnectFailed__"
+	resumeEventType                              = "__Resume__"
+	roleCreatedEventType                         = "RoleCreated"
+	roleDeletedEventType                         = "RoleDeleted"
+	roleUpdatedEventType                         = "RoleUpdated"
+	teamChannelCreatedEventType                  = "TeamChannelCreated"
+	teamChannelUpdatedEventType                  = "TeamChannelUpdated"
+	teamMemberBannedEventType                    = "TeamMemberBanned"
+	teamMemberJoinedEventType                    = "TeamMemberJoined"
+	teamMemberRemovedEventType                   = "TeamMemberRemoved"
+	teamMemberUnbannedEventType                  = "TeamMemberUnbanned"
+	teamMemberUpdatedEventType                   = "TeamMemberUpdated"
+	teamRolesUpdatedEventType                    = "TeamRolesUpdated"
+	teamWebhookCreatedEventType                  = "TeamWebhookCreated"
+	teamWebhookUpdatedEventType                  = "TeamWebhookUpdated"
+	userStatusCreatedEventType                   = "UserStatusCreated"
+	userStatusDeletedEventType                   = "UserStatusDeleted"
 )
 
+// announcementCommentCreatedEventHandler is an event handler for AnnouncementCommentCreated events.
+type announcementCommentCreatedEventHandler func(*Session, *AnnouncementCommentCreated)
+
+// Type returns the event type for AnnouncementCommentCreated events.
+func (eh announcementCommentCreatedEventHandler) Type() string {
+	return announcementCommentCreatedEventType
+}
+
+// New returns a new instance of AnnouncementCommentCreated.
+func (eh announcementCommentCreatedEventHandler) New() interface{} {
+	return &AnnouncementCommentCreated{}
+}
+
+// Handle is the handler for AnnouncementCommentCreated events.
+func (eh announcementCommentCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementCommentCreated); ok {
+		eh(s, t)
+	}
+}
+
+// announcementCommentDeletedEventHandler is an event handler for AnnouncementCommentDeleted events.
+type announcementCommentDeletedEventHandler func(*Session, *AnnouncementCommentDeleted)
+
+// Type returns the event type for AnnouncementCommentDeleted events.
+func (eh announcementCommentDeletedEventHandler) Type() string {
+	return announcementCommentDeletedEventType
+}
+
+// New returns a new instance of AnnouncementCommentDeleted.
+func (eh announcementCommentDeletedEventHandler) New() interface{} {
+	return &AnnouncementCommentDeleted{}
+}
+
+// Handle is the handler for AnnouncementCommentDeleted events.
+func (eh announcementCommentDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementCommentDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// announcementCommentUpdatedEventHandler is an event handler for AnnouncementCommentUpdated events.
+type announcementCommentUpdatedEventHandler func(*Session, *AnnouncementCommentUpdated)
+
+// Type returns the event type for AnnouncementCommentUpdated events.
+func (eh announcementCommentUpdatedEventHandler) Type() string {
+	return announcementCommentUpdatedEventType
+}
+
+// New returns a new instance of AnnouncementCommentUpdated.
+func (eh announcementCommentUpdatedEventHandler) New() interface{} {
+	return &AnnouncementCommentUpdated{}
+}
+
+// Handle is the handler for AnnouncementCommentUpdated events.
+func (eh announcementCommentUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementCommentUpdated); ok {
+		eh(s, t)
+	}
+}
+
+// announcementCreatedEventHandler is an event handler for AnnouncementCreated events.
+type announcementCreatedEventHandler func(*Session, *AnnouncementCreated)
+
+// Type returns the event type for AnnouncementCreated events.
+func (eh announcementCreatedEventHandler) Type() string {
+	return announcementCreatedEventType
+}
+
+// New returns a new instance of AnnouncementCreated.
+func (eh announcementCreatedEventHandler) New() interface{} {
+	return &AnnouncementCreated{}
+}
+
+// Handle is the handler for AnnouncementCreated events.
+func (eh announcementCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementCreated); ok {
+		eh(s, t)
+	}
+}
+
+// announcementDeletedEventHandler is an event handler for AnnouncementDeleted events.
+type announcementDeletedEventHandler func(*Session, *AnnouncementDeleted)
+
+// Type returns the event type for AnnouncementDeleted events.
+func (eh announcementDeletedEventHandler) Type() string {
+	return announcementDeletedEventType
+}
+
+// New returns a new instance of AnnouncementDeleted.
+func (eh announcementDeletedEventHandler) New() interface{} {
+	return &AnnouncementDeleted{}
+}
+
+// Handle is the handler for AnnouncementDeleted events.
+func (eh announcementDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// announcementReactionCreatedEventHandler is an event handler for AnnouncementReactionCreated events.
+type announcementReactionCreatedEventHandler func(*Session, *AnnouncementReactionCreated)
+
+// Type returns the event type for AnnouncementReactionCreated events.
+func (eh announcementReactionCreatedEventHandler) Type() string {
+	return announcementReactionCreatedEventType
+}
+
+// New returns a new instance of AnnouncementReactionCreated.
+func (eh announcementReactionCreatedEventHandler) New() interface{} {
+	return &AnnouncementReactionCreated{}
+}
+
+// Handle is the handler for AnnouncementReactionCreated events.
+func (eh announcementReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// announcementReactionDeletedEventHandler is an event handler for AnnouncementReactionDeleted events.
+type announcementReactionDeletedEventHandler func(*Session, *AnnouncementReactionDeleted)
+
+// Type returns the event type for AnnouncementReactionDeleted events.
+func (eh announcementReactionDeletedEventHandler) Type() string {
+	return announcementReactionDeletedEventType
+}
+
+// New returns a new instance of AnnouncementReactionDeleted.
+func (eh announcementReactionDeletedEventHandler) New() interface{} {
+	return &AnnouncementReactionDeleted{}
+}
+
+// Handle is the handler for AnnouncementReactionDeleted events.
+func (eh announcementReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// announcementUpdatedEventHandler is an event handler for AnnouncementUpdated events.
+type announcementUpdatedEventHandler func(*Session, *AnnouncementUpdated)
+
+// Type returns the event type for AnnouncementUpdated events.
+func (eh announcementUpdatedEventHandler) Type() string {
+	return announcementUpdatedEventType
+}
+
+// New returns a new instance of AnnouncementUpdated.
+func (eh announcementUpdatedEventHandler) New() interface{} {
+	return &AnnouncementUpdated{}
+}
+
+// Handle is the handler for AnnouncementUpdated events.
+func (eh announcementUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*AnnouncementUpdated); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventCommentCreatedEventHandler is an event handler for CalendarEventCommentCreated events.
+type calendarEventCommentCreatedEventHandler func(*Session, *CalendarEventCommentCreated)
+
+// Type returns the event type for CalendarEventCommentCreated events.
+func (eh calendarEventCommentCreatedEventHandler) Type() string {
+	return calendarEventCommentCreatedEventType
+}
+
+// New returns a new instance of CalendarEventCommentCreated.
+func (eh calendarEventCommentCreatedEventHandler) New() interface{} {
+	return &CalendarEventCommentCreated{}
+}
+
+// Handle is the handler for CalendarEventCommentCreated events.
+func (eh calendarEventCommentCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventCommentCreated); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventCommentDeletedEventHandler is an event handler for CalendarEventCommentDeleted events.
+type calendarEventCommentDeletedEventHandler func(*Session, *CalendarEventCommentDeleted)
+
+// Type returns the event type for CalendarEventCommentDeleted events.
+func (eh calendarEventCommentDeletedEventHandler) Type() string {
+	return calendarEventCommentDeletedEventType
+}
+
+// New returns a new instance of CalendarEventCommentDeleted.
+func (eh calendarEventCommentDeletedEventHandler) New() interface{} {
+	return &CalendarEventCommentDeleted{}
+}
+
+// Handle is the handler for CalendarEventCommentDeleted events.
+func (eh calendarEventCommentDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventCommentDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventCommentReactionCreatedEventHandler is an event handler for CalendarEventCommentReactionCreated events.
+type calendarEventCommentReactionCreatedEventHandler func(*Session, *CalendarEventCommentReactionCreated)
+
+// Type returns the event type for CalendarEventCommentReactionCreated events.
+func (eh calendarEventCommentReactionCreatedEventHandler) Type() string {
+	return calendarEventCommentReactionCreatedEventType
+}
+
+// New returns a new instance of CalendarEventCommentReactionCreated.
+func (eh calendarEventCommentReactionCreatedEventHandler) New() interface{} {
+	return &CalendarEventCommentReactionCreated{}
+}
+
+// Handle is the handler for CalendarEventCommentReactionCreated events.
+func (eh calendarEventCommentReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventCommentReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventCommentReactionDeletedEventHandler is an event handler for CalendarEventCommentReactionDeleted events.
+type calendarEventCommentReactionDeletedEventHandler func(*Session, *CalendarEventCommentReactionDeleted)
+
+// Type returns the event type for CalendarEventCommentReactionDeleted events.
+func (eh calendarEventCommentReactionDeletedEventHandler) Type() string {
+	return calendarEventCommentReactionDeletedEventType
+}
+
+// New returns a new instance of CalendarEventCommentReactionDeleted.
+func (eh calendarEventCommentReactionDeletedEventHandler) New() interface{} {
+	return &CalendarEventCommentReactionDeleted{}
+}
+
+// Handle is the handler for CalendarEventCommentReactionDeleted events.
+func (eh calendarEventCommentReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventCommentReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventCommentUpdatedEventHandler is an event handler for CalendarEventCommentUpdated events.
+type calendarEventCommentUpdatedEventHandler func(*Session, *CalendarEventCommentUpdated)
+
+// Type returns the event type for CalendarEventCommentUpdated events.
+func (eh calendarEventCommentUpdatedEventHandler) Type() string {
+	return calendarEventCommentUpdatedEventType
+}
+
+// New returns a new instance of CalendarEventCommentUpdated.
+func (eh calendarEventCommentUpdatedEventHandler) New() interface{} {
+	return &CalendarEventCommentUpdated{}
+}
+
+// Handle is the handler for CalendarEventCommentUpdated events.
+func (eh calendarEventCommentUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventCommentUpdated); ok {
+		eh(s, t)
+	}
+}
+
 // calendarEventCreatedEventHandler is an event handler for CalendarEventCreated events.
 type calendarEventCreatedEventHandler func(*Session, *CalendarEventCreated)
 
@@ -86,6 +390,46 @@ func (eh calendarEventDeletedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// calendarEventReactionCreatedEventHandler is an event handler for CalendarEventReactionCreated events.
+type calendarEventReactionCreatedEventHandler func(*Session, *CalendarEventReactionCreated)
+
+// Type returns the event type for CalendarEventReactionCreated events.
+func (eh calendarEventReactionCreatedEventHandler) Type() string {
+	return calendarEventReactionCreatedEventType
+}
+
+// New returns a new instance of CalendarEventReactionCreated.
+func (eh calendarEventReactionCreatedEventHandler) New() interface{} {
+	return &CalendarEventReactionCreated{}
+}
+
+// Handle is the handler for CalendarEventReactionCreated events.
+func (eh calendarEventReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventReactionDeletedEventHandler is an event handler for CalendarEventReactionDeleted events.
+type calendarEventReactionDeletedEventHandler func(*Session, *CalendarEventReactionDeleted)
+
+// Type returns the event type for CalendarEventReactionDeleted events.
+func (eh calendarEventReactionDeletedEventHandler) Type() string {
+	return calendarEventReactionDeletedEventType
+}
+
+// New returns a new instance of CalendarEventReactionDeleted.
+func (eh calendarEventReactionDeletedEventHandler) New() interface{} {
+	return &CalendarEventReactionDeleted{}
+}
+
+// Handle is the handler for CalendarEventReactionDeleted events.
+func (eh calendarEventReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
 // calendarEventRsvpDeletedEventHandler is an event handler for CalendarEventRsvpDeleted events.
 type calendarEventRsvpDeletedEventHandler func(*Session, *CalendarEventRsvpDeleted)
 
@@ -146,6 +490,46 @@ func (eh calendarEventRsvpUpdatedEventHandler) Handle(s *Session, i interface{})
 	}
 }
 
+// calendarEventSeriesDeletedEventHandler is an event handler for CalendarEventSeriesDeleted events.
+type calendarEventSeriesDeletedEventHandler func(*Session, *CalendarEventSeriesDeleted)
+
+// Type returns the event type for CalendarEventSeriesDeleted events.
+func (eh calendarEventSeriesDeletedEventHandler) Type() string {
+	return calendarEventSeriesDeletedEventType
+}
+
+// New returns a new instance of CalendarEventSeriesDeleted.
+func (eh calendarEventSeriesDeletedEventHandler) New() interface{} {
+	return &CalendarEventSeriesDeleted{}
+}
+
+// Handle is the handler for CalendarEventSeriesDeleted events.
+func (eh calendarEventSeriesDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventSeriesDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// calendarEventSeriesUpdatedEventHandler is an event handler for CalendarEventSeriesUpdated events.
+type calendarEventSeriesUpdatedEventHandler func(*Session, *CalendarEventSeriesUpdated)
+
+// Type returns the event type for CalendarEventSeriesUpdated events.
+func (eh calendarEventSeriesUpdatedEventHandler) Type() string {
+	return calendarEventSeriesUpdatedEventType
+}
+
+// New returns a new instance of CalendarEventSeriesUpdated.
+func (eh calendarEventSeriesUpdatedEventHandler) New() interface{} {
+	return &CalendarEventSeriesUpdated{}
+}
+
+// Handle is the handler for CalendarEventSeriesUpdated events.
+func (eh calendarEventSeriesUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*CalendarEventSeriesUpdated); ok {
+		eh(s, t)
+	}
+}
+
 // calendarEventUpdatedEventHandler is an event handler for CalendarEventUpdated events.
 type calendarEventUpdatedEventHandler func(*Session, *CalendarEventUpdated)
 
@@ -206,6 +590,26 @@ func (eh channelMessageReactionDeletedEventHandler) Handle(s *Session, i interfa
 	}
 }
 
+// channelMessageReactionManyDeletedEventHandler is an event handler for ChannelMessageReactionManyDeleted events.
+type channelMessageReactionManyDeletedEventHandler func(*Session, *ChannelMessageReactionManyDeleted)
+
+// Type returns the event type for ChannelMessageReactionManyDeleted events.
+func (eh channelMessageReactionManyDeletedEventHandler) Type() string {
+	return channelMessageReactionManyDeletedEventType
+}
+
+// New returns a new instance of ChannelMessageReactionManyDeleted.
+func (eh channelMessageReactionManyDeletedEventHandler) New() interface{} {
+	return &ChannelMessageReactionManyDeleted{}
+}
+
+// Handle is the handler for ChannelMessageReactionManyDeleted events.
+func (eh channelMessageReactionManyDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ChannelMessageReactionManyDeleted); ok {
+		eh(s, t)
+	}
+}
+
 // chatMessageCreatedEventHandler is an event handler for ChatMessageCreated events.
 type chatMessageCreatedEventHandler func(*Session, *ChatMessageCreated)
 
@@ -296,6 +700,106 @@ func (eh disconnectEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// docCommentCreatedEventHandler is an event handler for DocCommentCreated events.
+type docCommentCreatedEventHandler func(*Session, *DocCommentCreated)
+
+// Type returns the event type for DocCommentCreated events.
+func (eh docCommentCreatedEventHandler) Type() string {
+	return docCommentCreatedEventType
+}
+
+// New returns a new instance of DocCommentCreated.
+func (eh docCommentCreatedEventHandler) New() interface{} {
+	return &DocCommentCreated{}
+}
+
+// Handle is the handler for DocCommentCreated events.
+func (eh docCommentCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocCommentCreated); ok {
+		eh(s, t)
+	}
+}
+
+// docCommentDeletedEventHandler is an event handler for DocCommentDeleted events.
+type docCommentDeletedEventHandler func(*Session, *DocCommentDeleted)
+
+// Type returns the event type for DocCommentDeleted events.
+func (eh docCommentDeletedEventHandler) Type() string {
+	return docCommentDeletedEventType
+}
+
+// New returns a new instance of DocCommentDeleted.
+func (eh docCommentDeletedEventHandler) New() interface{} {
+	return &DocCommentDeleted{}
+}
+
+// Handle is the handler for DocCommentDeleted events.
+func (eh docCommentDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocCommentDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// docCommentReactionCreatedEventHandler is an event handler for DocCommentReactionCreated events.
+type docCommentReactionCreatedEventHandler func(*Session, *DocCommentReactionCreated)
+
+// Type returns the event type for DocCommentReactionCreated events.
+func (eh docCommentReactionCreatedEventHandler) Type() string {
+	return docCommentReactionCreatedEventType
+}
+
+// New returns a new instance of DocCommentReactionCreated.
+func (eh docCommentReactionCreatedEventHandler) New() interface{} {
+	return &DocCommentReactionCreated{}
+}
+
+// Handle is the handler for DocCommentReactionCreated events.
+func (eh docCommentReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocCommentReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// docCommentReactionDeletedEventHandler is an event handler for DocCommentReactionDeleted events.
+type docCommentReactionDeletedEventHandler func(*Session, *DocCommentReactionDeleted)
+
+// Type returns the event type for DocCommentReactionDeleted events.
+func (eh docCommentReactionDeletedEventHandler) Type() string {
+	return docCommentReactionDeletedEventType
+}
+
+// New returns a new instance of DocCommentReactionDeleted.
+func (eh docCommentReactionDeletedEventHandler) New() interface{} {
+	return &DocCommentReactionDeleted{}
+}
+
+// Handle is the handler for DocCommentReactionDeleted events.
+func (eh docCommentReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocCommentReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// docCommentUpdatedEventHandler is an event handler for DocCommentUpdated events.
+type docCommentUpdatedEventHandler func(*Session, *DocCommentUpdated)
+
+// Type returns the event type for DocCommentUpdated events.
+func (eh docCommentUpdatedEventHandler) Type() string {
+	return docCommentUpdatedEventType
+}
+
+// New returns a new instance of DocCommentUpdated.
+func (eh docCommentUpdatedEventHandler) New() interface{} {
+	return &DocCommentUpdated{}
+}
+
+// Handle is the handler for DocCommentUpdated events.
+func (eh docCommentUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocCommentUpdated); ok {
+		eh(s, t)
+	}
+}
+
 // docCreatedEventHandler is an event handler for DocCreated events.
 type docCreatedEventHandler func(*Session, *DocCreated)
 
@@ -336,6 +840,46 @@ func (eh docDeletedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// docReactionCreatedEventHandler is an event handler for DocReactionCreated events.
+type docReactionCreatedEventHandler func(*Session, *DocReactionCreated)
+
+// Type returns the event type for DocReactionCreated events.
+func (eh docReactionCreatedEventHandler) Type() string {
+	return docReactionCreatedEventType
+}
+
+// New returns a new instance of DocReactionCreated.
+func (eh docReactionCreatedEventHandler) New() interface{} {
+	return &DocReactionCreated{}
+}
+
+// Handle is the handler for DocReactionCreated events.
+func (eh docReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// docReactionDeletedEventHandler is an event handler for DocReactionDeleted events.
+type docReactionDeletedEventHandler func(*Session, *DocReactionDeleted)
+
+// Type returns the event type for DocReactionDeleted events.
+func (eh docReactionDeletedEventHandler) Type() string {
+	return docReactionDeletedEventType
+}
+
+// New returns a new instance of DocReactionDeleted.
+func (eh docReactionDeletedEventHandler) New() interface{} {
+	return &DocReactionDeleted{}
+}
+
+// Handle is the handler for DocReactionDeleted events.
+func (eh docReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*DocReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
 // docUpdatedEventHandler is an event handler for DocUpdated events.
 type docUpdatedEventHandler func(*Session, *DocUpdated)
 
@@ -371,6 +915,106 @@ func (eh eventEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// forumTopicCommentCreatedEventHandler is an event handler for ForumTopicCommentCreated events.
+type forumTopicCommentCreatedEventHandler func(*Session, *ForumTopicCommentCreated)
+
+// Type returns the event type for ForumTopicCommentCreated events.
+func (eh forumTopicCommentCreatedEventHandler) Type() string {
+	return forumTopicCommentCreatedEventType
+}
+
+// New returns a new instance of ForumTopicCommentCreated.
+func (eh forumTopicCommentCreatedEventHandler) New() interface{} {
+	return &ForumTopicCommentCreated{}
+}
+
+// Handle is the handler for ForumTopicCommentCreated events.
+func (eh forumTopicCommentCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicCommentCreated); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicCommentDeletedEventHandler is an event handler for ForumTopicCommentDeleted events.
+type forumTopicCommentDeletedEventHandler func(*Session, *ForumTopicCommentDeleted)
+
+// Type returns the event type for ForumTopicCommentDeleted events.
+func (eh forumTopicCommentDeletedEventHandler) Type() string {
+	return forumTopicCommentDeletedEventType
+}
+
+// New returns a new instance of ForumTopicCommentDeleted.
+func (eh forumTopicCommentDeletedEventHandler) New() interface{} {
+	return &ForumTopicCommentDeleted{}
+}
+
+// Handle is the handler for ForumTopicCommentDeleted events.
+func (eh forumTopicCommentDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicCommentDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicCommentReactionCreatedEventHandler is an event handler for ForumTopicCommentReactionCreated events.
+type forumTopicCommentReactionCreatedEventHandler func(*Session, *ForumTopicCommentReactionCreated)
+
+// Type returns the event type for ForumTopicCommentReactionCreated events.
+func (eh forumTopicCommentReactionCreatedEventHandler) Type() string {
+	return forumTopicCommentReactionCreatedEventType
+}
+
+// New returns a new instance of ForumTopicCommentReactionCreated.
+func (eh forumTopicCommentReactionCreatedEventHandler) New() interface{} {
+	return &ForumTopicCommentReactionCreated{}
+}
+
+// Handle is the handler for ForumTopicCommentReactionCreated events.
+func (eh forumTopicCommentReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicCommentReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicCommentReactionDeletedEventHandler is an event handler for ForumTopicCommentReactionDeleted events.
+type forumTopicCommentReactionDeletedEventHandler func(*Session, *ForumTopicCommentReactionDeleted)
+
+// Type returns the event type for ForumTopicCommentReactionDeleted events.
+func (eh forumTopicCommentReactionDeletedEventHandler) Type() string {
+	return forumTopicCommentReactionDeletedEventType
+}
+
+// New returns a new instance of ForumTopicCommentReactionDeleted.
+func (eh forumTopicCommentReactionDeletedEventHandler) New() interface{} {
+	return &ForumTopicCommentReactionDeleted{}
+}
+
+// Handle is the handler for ForumTopicCommentReactionDeleted events.
+func (eh forumTopicCommentReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicCommentReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicCommentUpdatedEventHandler is an event handler for ForumTopicCommentUpdated events.
+type forumTopicCommentUpdatedEventHandler func(*Session, *ForumTopicCommentUpdated)
+
+// Type returns the event type for ForumTopicCommentUpdated events.
+func (eh forumTopicCommentUpdatedEventHandler) Type() string {
+	return forumTopicCommentUpdatedEventType
+}
+
+// New returns a new instance of ForumTopicCommentUpdated.
+func (eh forumTopicCommentUpdatedEventHandler) New() interface{} {
+	return &ForumTopicCommentUpdated{}
+}
+
+// Handle is the handler for ForumTopicCommentUpdated events.
+func (eh forumTopicCommentUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicCommentUpdated); ok {
+		eh(s, t)
+	}
+}
+
 // forumTopicCreatedEventHandler is an event handler for ForumTopicCreated events.
 type forumTopicCreatedEventHandler func(*Session, *ForumTopicCreated)
 
@@ -411,6 +1055,126 @@ func (eh forumTopicDeletedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// forumTopicLockedEventHandler is an event handler for ForumTopicLocked events.
+type forumTopicLockedEventHandler func(*Session, *ForumTopicLocked)
+
+// Type returns the event type for ForumTopicLocked events.
+func (eh forumTopicLockedEventHandler) Type() string {
+	return forumTopicLockedEventType
+}
+
+// New returns a new instance of ForumTopicLocked.
+func (eh forumTopicLockedEventHandler) New() interface{} {
+	return &ForumTopicLocked{}
+}
+
+// Handle is the handler for ForumTopicLocked events.
+func (eh forumTopicLockedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicLocked); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicPinnedEventHandler is an event handler for ForumTopicPinned events.
+type forumTopicPinnedEventHandler func(*Session, *ForumTopicPinned)
+
+// Type returns the event type for ForumTopicPinned events.
+func (eh forumTopicPinnedEventHandler) Type() string {
+	return forumTopicPinnedEventType
+}
+
+// New returns a new instance of ForumTopicPinned.
+func (eh forumTopicPinnedEventHandler) New() interface{} {
+	return &ForumTopicPinned{}
+}
+
+// Handle is the handler for ForumTopicPinned events.
+func (eh forumTopicPinnedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicPinned); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicReactionCreatedEventHandler is an event handler for ForumTopicReactionCreated events.
+type forumTopicReactionCreatedEventHandler func(*Session, *ForumTopicReactionCreated)
+
+// Type returns the event type for ForumTopicReactionCreated events.
+func (eh forumTopicReactionCreatedEventHandler) Type() string {
+	return forumTopicReactionCreatedEventType
+}
+
+// New returns a new instance of ForumTopicReactionCreated.
+func (eh forumTopicReactionCreatedEventHandler) New() interface{} {
+	return &ForumTopicReactionCreated{}
+}
+
+// Handle is the handler for ForumTopicReactionCreated events.
+func (eh forumTopicReactionCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicReactionCreated); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicReactionDeletedEventHandler is an event handler for ForumTopicReactionDeleted events.
+type forumTopicReactionDeletedEventHandler func(*Session, *ForumTopicReactionDeleted)
+
+// Type returns the event type for ForumTopicReactionDeleted events.
+func (eh forumTopicReactionDeletedEventHandler) Type() string {
+	return forumTopicReactionDeletedEventType
+}
+
+// New returns a new instance of ForumTopicReactionDeleted.
+func (eh forumTopicReactionDeletedEventHandler) New() interface{} {
+	return &ForumTopicReactionDeleted{}
+}
+
+// Handle is the handler for ForumTopicReactionDeleted events.
+func (eh forumTopicReactionDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicReactionDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicUnlockedEventHandler is an event handler for ForumTopicUnlocked events.
+type forumTopicUnlockedEventHandler func(*Session, *ForumTopicUnlocked)
+
+// Type returns the event type for ForumTopicUnlocked events.
+func (eh forumTopicUnlockedEventHandler) Type() string {
+	return forumTopicUnlockedEventType
+}
+
+// New returns a new instance of ForumTopicUnlocked.
+func (eh forumTopicUnlockedEventHandler) New() interface{} {
+	return &ForumTopicUnlocked{}
+}
+
+// Handle is the handler for ForumTopicUnlocked events.
+func (eh forumTopicUnlockedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicUnlocked); ok {
+		eh(s, t)
+	}
+}
+
+// forumTopicUnpinnedEventHandler is an event handler for ForumTopicUnpinned events.
+type forumTopicUnpinnedEventHandler func(*Session, *ForumTopicUnpinned)
+
+// Type returns the event type for ForumTopicUnpinned events.
+func (eh forumTopicUnpinnedEventHandler) Type() string {
+	return forumTopicUnpinnedEventType
+}
+
+// New returns a new instance of ForumTopicUnpinned.
+func (eh forumTopicUnpinnedEventHandler) New() interface{} {
+	return &ForumTopicUnpinned{}
+}
+
+// Handle is the handler for ForumTopicUnpinned events.
+func (eh forumTopicUnpinnedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ForumTopicUnpinned); ok {
+		eh(s, t)
+	}
+}
+
 // forumTopicUpdatedEventHandler is an event handler for ForumTopicUpdated events.
 type forumTopicUpdatedEventHandler func(*Session, *ForumTopicUpdated)
 
@@ -431,6 +1195,36 @@ func (eh forumTopicUpdatedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// gatewayInternalErrorEventHandler is an event handler for GatewayInternalError events.
+type gatewayInternalErrorEventHandler func(*Session, *GatewayInternalError)
+
+// Type returns the event type for GatewayInternalError events.
+func (eh gatewayInternalErrorEventHandler) Type() string {
+	return gatewayInternalErrorEventType
+}
+
+// Handle is the handler for GatewayInternalError events.
+func (eh gatewayInternalErrorEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GatewayInternalError); ok {
+		eh(s, t)
+	}
+}
+
+// gatewayInvalidCursorEventHandler is an event handler for GatewayInvalidCursor events.
+type gatewayInvalidCursorEventHandler func(*Session, *GatewayInvalidCursor)
+
+// Type returns the event type for GatewayInvalidCursor events.
+func (eh gatewayInvalidCursorEventHandler) Type() string {
+	return gatewayInvalidCursorEventType
+}
+
+// Handle is the handler for GatewayInvalidCursor events.
+func (eh gatewayInvalidCursorEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*GatewayInvalidCursor); ok {
+		eh(s, t)
+	}
+}
+
 // listItemCompletedEventHandler is an event handler for ListItemCompleted events.
 type listItemCompletedEventHandler func(*Session, *ListItemCompleted)
 
@@ -546,6 +1340,21 @@ func (eh readyEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// reconnectFailedEventHandler is an event handler for ReconnectFailed events.
+type reconnectFailedEventHandler func(*Session, *ReconnectFailed)
+
+// Type returns the event type for ReconnectFailed events.
+func (eh reconnectFailedEventHandler) Type() string {
+	return reconnectFailedEventType
+}
+
+// Handle is the handler for ReconnectFailed events.
+func (eh reconnectFailedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*ReconnectFailed); ok {
+		eh(s, t)
+	}
+}
+
 // resumeEventHandler is an event handler for Resume events.
 type resumeEventHandler func(*Session, *Resume)
 
@@ -561,6 +1370,66 @@ func (eh resumeEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// roleCreatedEventHandler is an event handler for RoleCreated events.
+type roleCreatedEventHandler func(*Session, *RoleCreated)
+
+// Type returns the event type for RoleCreated events.
+func (eh roleCreatedEventHandler) Type() string {
+	return roleCreatedEventType
+}
+
+// New returns a new instance of RoleCreated.
+func (eh roleCreatedEventHandler) New() interface{} {
+	return &RoleCreated{}
+}
+
+// Handle is the handler for RoleCreated events.
+func (eh roleCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*RoleCreated); ok {
+		eh(s, t)
+	}
+}
+
+// roleDeletedEventHandler is an event handler for RoleDeleted events.
+type roleDeletedEventHandler func(*Session, *RoleDeleted)
+
+// Type returns the event type for RoleDeleted events.
+func (eh roleDeletedEventHandler) Type() string {
+	return roleDeletedEventType
+}
+
+// New returns a new instance of RoleDeleted.
+func (eh roleDeletedEventHandler) New() interface{} {
+	return &RoleDeleted{}
+}
+
+// Handle is the handler for RoleDeleted events.
+func (eh roleDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*RoleDeleted); ok {
+		eh(s, t)
+	}
+}
+
+// roleUpdatedEventHandler is an event handler for RoleUpdated events.
+type roleUpdatedEventHandler func(*Session, *RoleUpdated)
+
+// Type returns the event type for RoleUpdated events.
+func (eh roleUpdatedEventHandler) Type() string {
+	return roleUpdatedEventType
+}
+
+// New returns a new instance of RoleUpdated.
+func (eh roleUpdatedEventHandler) New() interface{} {
+	return &RoleUpdated{}
+}
+
+// Handle is the handler for RoleUpdated events.
+func (eh roleUpdatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*RoleUpdated); ok {
+		eh(s, t)
+	}
+}
+
 // teamChannelCreatedEventHandler is an event handler for TeamChannelCreated events.
 type teamChannelCreatedEventHandler func(*Session, *TeamChannelCreated)
 
@@ -761,26 +1630,102 @@ func (eh teamWebhookUpdatedEventHandler) Handle(s *Session, i interface{}) {
 	}
 }
 
+// userStatusCreatedEventHandler is an event handler for UserStatusCreated events.
+type userStatusCreatedEventHandler func(*Session, *UserStatusCreated)
+
+// Type returns the event type for UserStatusCreated events.
+func (eh userStatusCreatedEventHandler) Type() string {
+	return userStatusCreatedEventType
+}
+
+// New returns a new instance of UserStatusCreated.
+func (eh userStatusCreatedEventHandler) New() interface{} {
+	return &UserStatusCreated{}
+}
+
+// Handle is the handler for UserStatusCreated events.
+func (eh userStatusCreatedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*UserStatusCreated); ok {
+		eh(s, t)
+	}
+}
+
+// userStatusDeletedEventHandler is an event handler for UserStatusDeleted events.
+type userStatusDeletedEventHandler func(*Session, *UserStatusDeleted)
+
+// Type returns the event type for UserStatusDeleted events.
+func (eh userStatusDeletedEventHandler) Type() string {
+	return userStatusDeletedEventType
+}
+
+// New returns a new instance of UserStatusDeleted.
+func (eh userStatusDeletedEventHandler) New() interface{} {
+	return &UserStatusDeleted{}
+}
+
+// Handle is the handler for UserStatusDeleted events.
+func (eh userStatusDeletedEventHandler) Handle(s *Session, i interface{}) {
+	if t, ok := i.(*UserStatusDeleted); ok {
+		eh(s, t)
+	}
+}
+
 func handlerForInterface(handler interface{}) EventHandler {
 	switch v := handler.(type) {
 	case func(*Session, interface{}):
 		return interfaceEventHandler(v)
+	case func(*Session, *AnnouncementCommentCreated):
+		return announcementCommentCreatedEventHandler(v)
+	case func(*Session, *AnnouncementCommentDeleted):
+		return announcementCommentDeletedEventHandler(v)
+	case func(*Session, *AnnouncementCommentUpdated):
+		return announcementCommentUpdatedEventHandler(v)
+	case func(*Session, *AnnouncementCreated):
+		return announcementCreatedEventHandler(v)
+	case func(*Session, *AnnouncementDeleted):
+		return announcementDeletedEventHandler(v)
+	case func(*Session, *AnnouncementReactionCreated):
+		return announcementReactionCreatedEventHandler(v)
+	case func(*Session, *AnnouncementReactionDeleted):
+		return announcementReactionDeletedEventHandler(v)
+	case func(*Session, *AnnouncementUpdated):
+		return announcementUpdatedEventHandler(v)
+	case func(*Session, *CalendarEventCommentCreated):
+		return calendarEventCommentCreatedEventHandler(v)
+	case func(*Session, *CalendarEventCommentDeleted):
+		return calendarEventCommentDeletedEventHandler(v)
+	case func(*Session, *CalendarEventCommentReactionCreated):
+		return calendarEventCommentReactionCreatedEventHandler(v)
+	case func(*Session, *CalendarEventCommentReactionDeleted):
+		return calendarEventCommentReactionDeletedEventHandler(v)
+	case func(*Session, *CalendarEventCommentUpdated):
+		return calendarEventCommentUpdatedEventHandler(v)
 	case func(*Session, *CalendarEventCreated):
 		return calendarEventCreatedEventHandler(v)
 	case func(*Session, *CalendarEventDeleted):
 		return calendarEventDeletedEventHandler(v)
+	case func(*Session, *CalendarEventReactionCreated):
+		return calendarEventReactionCreatedEventHandler(v)
+	case func(*Session, *CalendarEventReactionDeleted):
+		return calendarEventReactionDeletedEventHandler(v)
 	case func(*Session, *CalendarEventRsvpDeleted):
 		return calendarEventRsvpDeletedEventHandler(v)
 	case func(*Session, *CalendarEventRsvpManyUpdated):
 		return calendarEventRsvpManyUpdatedEventHandler(v)
 	case func(*Session, *CalendarEventRsvpUpdated):
 		return calendarEventRsvpUpdatedEventHandler(v)
+	case func(*Session, *CalendarEventSeriesDeleted):
+		return calendarEventSeriesDeletedEventHandler(v)
+	case func(*Session, *CalendarEventSeriesUpdated):
+		return calendarEventSeriesUpdatedEventHandler(v)
 	case func(*Session, *CalendarEventUpdated):
 		return calendarEventUpdatedEventHandler(v)
 	case func(*Session, *ChannelMessageReactionCreated):
 		return channelMessageReactionCreatedEventHandler(v)
 	case func(*Session, *ChannelMessageReactionDeleted):
 		return channelMessageReactionDeletedEventHandler(v)
+	case func(*Session, *ChannelMessageReactionManyDeleted):
+		return channelMessageReactionManyDeletedEventHandler(v)
 	case func(*Session, *ChatMessageCreated):
 		return chatMessageCreatedEventHandler(v)
 	case func(*Session, *ChatMessageDeleted):
@@ -791,20 +1736,60 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return connectEventHandler(v)
 	case func(*Session, *Disconnect):
 		return disconnectEventHandler(v)
+	case func(*Session, *DocCommentCreated):
+		return docCommentCreatedEventHandler(v)
+	case func(*Session, *DocCommentDeleted):
+		return docCommentDeletedEventHandler(v)
+	case func(*Session, *DocCommentReactionCreated):
+		return docCommentReactionCreatedEventHandler(v)
+	case func(*Session, *DocCommentReactionDeleted):
+		return docCommentReactionDeletedEventHandler(v)
+	case func(*Session, *DocCommentUpdated):
+		return docCommentUpdatedEventHandler(v)
 	case func(*Session, *DocCreated):
 		return docCreatedEventHandler(v)
 	case func(*Session, *DocDeleted):
 		return docDeletedEventHandler(v)
+	case func(*Session, *DocReactionCreated):
+		return docReactionCreatedEventHandler(v)
+	case func(*Session, *DocReactionDeleted):
+		return docReactionDeletedEventHandler(v)
 	case func(*Session, *DocUpdated):
 		return docUpdatedEventHandler(v)
 	case func(*Session, *Event):
 		return eventEventHandler(v)
+	case func(*Session, *ForumTopicCommentCreated):
+		return forumTopicCommentCreatedEventHandler(v)
+	case func(*Session, *ForumTopicCommentDeleted):
+		return forumTopicCommentDeletedEventHandler(v)
+	case func(*Session, *ForumTopicCommentReactionCreated):
+		return forumTopicCommentReactionCreatedEventHandler(v)
+	case func(*Session, *ForumTopicCommentReactionDeleted):
+		return forumTopicCommentReactionDeletedEventHandler(v)
+	case func(*Session, *ForumTopicCommentUpdated):
+		return forumTopicCommentUpdatedEventHandler(v)
 	case func(*Session, *ForumTopicCreated):
 		return forumTopicCreatedEventHandler(v)
 	case func(*Session, *ForumTopicDeleted):
 		return forumTopicDeletedEventHandler(v)
+	case func(*Session, *ForumTopicLocked):
+		return forumTopicLockedEventHandler(v)
+	case func(*Session, *ForumTopicPinned):
+		return forumTopicPinnedEventHandler(v)
+	case func(*Session, *ForumTopicReactionCreated):
+		return forumTopicReactionCreatedEventHandler(v)
+	case func(*Session, *ForumTopicReactionDeleted):
+		return forumTopicReactionDeletedEventHandler(v)
+	case func(*Session, *ForumTopicUnlocked):
+		return forumTopicUnlockedEventHandler(v)
+	case func(*Session, *ForumTopicUnpinned):
+		return forumTopicUnpinnedEventHandler(v)
 	case func(*Session, *ForumTopicUpdated):
 		return forumTopicUpdatedEventHandler(v)
+	case func(*Session, *GatewayInternalError):
+		return gatewayInternalErrorEventHandler(v)
+	case func(*Session, *GatewayInvalidCursor):
+		return gatewayInvalidCursorEventHandler(v)
 	case func(*Session, *ListItemCompleted):
 		return listItemCompletedEventHandler(v)
 	case func(*Session, *ListItemCreated):
@@ -817,8 +1802,16 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return rateLimitEventHandler(v)
 	case func(*Session, *Ready):
 		return readyEventHandler(v)
+	case func(*Session, *ReconnectFailed):
+		return reconnectFailedEventHandler(v)
 	case func(*Session, *Resume):
 		return resumeEventHandler(v)
+	case func(*Session, *RoleCreated):
+		return roleCreatedEventHandler(v)
+	case func(*Session, *RoleDeleted):
+		return roleDeletedEventHandler(v)
+	case func(*Session, *RoleUpdated):
+		return roleUpdatedEventHandler(v)
 	case func(*Session, *TeamChannelCreated):
 		return teamChannelCreatedEventHandler(v)
 	case func(*Session, *TeamChannelUpdated):
@@ -839,34 +1832,77 @@ func handlerForInterface(handler interface{}) EventHandler {
 		return teamWebhookCreatedEventHandler(v)
 	case func(*Session, *TeamWebhookUpdated):
 		return teamWebhookUpdatedEventHandler(v)
+	case func(*Session, *UserStatusCreated):
+		return userStatusCreatedEventHandler(v)
+	case func(*Session, *UserStatusDeleted):
+		return userStatusDeletedEventHandler(v)
 	}
 
 	return nil
 }
 
 func init() {
+	registerInterfaceProvider(announcementCommentCreatedEventHandler(nil))
+	registerInterfaceProvider(announcementCommentDeletedEventHandler(nil))
+	registerInterfaceProvider(announcementCommentUpdatedEventHandler(nil))
+	registerInterfaceProvider(announcementCreatedEventHandler(nil))
+	registerInterfaceProvider(announcementDeletedEventHandler(nil))
+	registerInterfaceProvider(announcementReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(announcementReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(announcementUpdatedEventHandler(nil))
+	registerInterfaceProvider(calendarEventCommentCreatedEventHandler(nil))
+	registerInterfaceProvider(calendarEventCommentDeletedEventHandler(nil))
+	registerInterfaceProvider(calendarEventCommentReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(calendarEventCommentReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(calendarEventCommentUpdatedEventHandler(nil))
 	registerInterfaceProvider(calendarEventCreatedEventHandler(nil))
 	registerInterfaceProvider(calendarEventDeletedEventHandler(nil))
+	registerInterfaceProvider(calendarEventReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(calendarEventReactionDeletedEventHandler(nil))
 	registerInterfaceProvider(calendarEventRsvpDeletedEventHandler(nil))
 	registerInterfaceProvider(calendarEventRsvpManyUpdatedEventHandler(nil))
 	registerInterfaceProvider(calendarEventRsvpUpdatedEventHandler(nil))
+	registerInterfaceProvider(calendarEventSeriesDeletedEventHandler(nil))
+	registerInterfaceProvider(calendarEventSeriesUpdatedEventHandler(nil))
 	registerInterfaceProvider(calendarEventUpdatedEventHandler(nil))
 	registerInterfaceProvider(channelMessageReactionCreatedEventHandler(nil))
 	registerInterfaceProvider(channelMessageReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(channelMessageReactionManyDeletedEventHandler(nil))
 	registerInterfaceProvider(chatMessageCreatedEventHandler(nil))
 	registerInterfaceProvider(chatMessageDeletedEventHandler(nil))
 	registerInterfaceProvider(chatMessageUpdatedEventHandler(nil))
+	registerInterfaceProvider(docCommentCreatedEventHandler(nil))
+	registerInterfaceProvider(docCommentDeletedEventHandler(nil))
+	registerInterfaceProvider(docCommentReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(docCommentReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(docCommentUpdatedEventHandler(nil))
 	registerInterfaceProvider(docCreatedEventHandler(nil))
 	registerInterfaceProvider(docDeletedEventHandler(nil))
+	registerInterfaceProvider(docReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(docReactionDeletedEventHandler(nil))
 	registerInterfaceProvider(docUpdatedEventHandler(nil))
+	registerInterfaceProvider(forumTopicCommentCreatedEventHandler(nil))
+	registerInterfaceProvider(forumTopicCommentDeletedEventHandler(nil))
+	registerInterfaceProvider(forumTopicCommentReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(forumTopicCommentReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(forumTopicCommentUpdatedEventHandler(nil))
 	registerInterfaceProvider(forumTopicCreatedEventHandler(nil))
 	registerInterfaceProvider(forumTopicDeletedEventHandler(nil))
+	registerInterfaceProvider(forumTopicLockedEventHandler(nil))
+	registerInterfaceProvider(forumTopicPinnedEventHandler(nil))
+	registerInterfaceProvider(forumTopicReactionCreatedEventHandler(nil))
+	registerInterfaceProvider(forumTopicReactionDeletedEventHandler(nil))
+	registerInterfaceProvider(forumTopicUnlockedEventHandler(nil))
+	registerInterfaceProvider(forumTopicUnpinnedEventHandler(nil))
 	registerInterfaceProvider(forumTopicUpdatedEventHandler(nil))
 	registerInterfaceProvider(listItemCompletedEventHandler(nil))
 	registerInterfaceProvider(listItemCreatedEventHandler(nil))
 	registerInterfaceProvider(listItemDeletedEventHandler(nil))
 	registerInterfaceProvider(listItemUpdatedEventHandler(nil))
 	registerInterfaceProvider(readyEventHandler(nil))
+	registerInterfaceProvider(roleCreatedEventHandler(nil))
+	registerInterfaceProvider(roleDeletedEventHandler(nil))
+	registerInterfaceProvider(roleUpdatedEventHandler(nil))
 	registerInterfaceProvider(teamChannelCreatedEventHandler(nil))
 	registerInterfaceProvider(teamChannelUpdatedEventHandler(nil))
 	registerInterfaceProvider(teamMemberBannedEventHandler(nil))
@@ -877,4 +1913,6 @@ func init() {
 	registerInterfaceProvider(teamRolesUpdatedEventHandler(nil))
 	registerInterfaceProvider(teamWebhookCreatedEventHandler(nil))
 	registerInterfaceProvider(teamWebhookUpdatedEventHandler(nil))
+	registerInterfaceProvider(userStatusCreatedEventHandler(nil))
+	registerInterfaceProvider(userStatusDeletedEventHandler(nil))
 }
@@ -16,11 +16,46 @@ type Connect struct{}
 
 // Disconnect is the data for a Disconnect event.
 // This is a synthetic event and is not dispatched by Guilded.
-type Disconnect struct{}
+type Disconnect struct {
+	// Close code of the websocket connection
+	Code GatewayCloseCode
+	// Close reason sent by Guilded, if any
+	Reason string
+	// Whether the connection was closed by Guilded
+	ByServer bool
+}
 
 // Resume is the data for a Resume event.
+// It is emitted on an Op 2 message, after reconnecting with a last message ID.
 // This is a synthetic event and is not dispatched by Guilded.
-type Resume struct{}
+type Resume struct {
+	LastMessageID string `json:"lastMessageId"`
+}
+
+// GatewayInvalidCursor is the data for a GatewayInvalidCursor event.
+// It is emitted on an Op 8 message, when the last message ID used to replay
+// missed events was rejected. LastEventID is cleared before it is emitted.
+// This is a synthetic event and is not dispatched by Guilded.
+type GatewayInvalidCursor struct {
+	Message string
+}
+
+// GatewayInternalError is the data for a GatewayInternalError event.
+// It is emitted on an Op 9 message, when the gateway failed internally.
+// This is a synthetic event and is not dispatched by Guilded.
+type GatewayInternalError struct {
+	Message string
+}
+
+// ReconnectFailed is the data for a ReconnectFailed event.
+// It is emitted once the session stops trying to reconnect to Guilded,
+// because the ReconnectPolicy gave up, the token was rejected or Close was called.
+// This is a synthetic event and is not dispatched by Guilded.
+type ReconnectFailed struct {
+	Attempts int
+	Elapsed  time.Duration
+	Err      error
+}
 
 // RateLimit is the data for a RateLimit event.
 // This is a synthetic event and is not dispatched by Guilded.
@@ -31,7 +66,7 @@ type RateLimit struct {
 
 // Event provides a basic initial struct for all websocket events.
 type Event struct {
-	Operation int             `json:"op"`
+	Operation GatewayOp       `json:"op"`
 	MessageID string          `json:"s"`
 	Type      string          `json:"t"`
 	RawData   json.RawMessage `json:"d"`
@@ -41,132 +76,314 @@ type Event struct {
 
 // Is the data for the ChatMessageCreated event
 type ChatMessageCreated struct {
-	ServerID string      `json:"serverId"`
+	ServerID ServerID    `json:"serverId"`
 	Message  ChatMessage `json:"message"`
 }
 
 type ChatMessageUpdated struct {
-	ServerID string      `json:"serverId"`
+	ServerID ServerID    `json:"serverId"`
 	Message  ChatMessage `json:"message"`
 }
 
 type ChatMessageDeleted struct {
-	ServerID string      `json:"serverId"`
+	ServerID ServerID    `json:"serverId"`
 	Message  ChatMessage `json:"message"`
 }
 
 type TeamMemberJoined struct {
-	ServerID string       `json:"serverId"`
+	ServerID ServerID     `json:"serverId"`
 	Member   ServerMember `json:"member"`
 }
 
 type TeamMemberRemoved struct {
-	ServerID string `json:"serverId"`
-	UserID   string `json:"userId"`
-	IsKick   bool   `json:"isKick"`
-	IsBan    bool   `json:"isBan"`
+	ServerID ServerID `json:"serverId"`
+	UserID   UserID   `json:"userId"`
+	IsKick   bool     `json:"isKick"`
+	IsBan    bool     `json:"isBan"`
 }
 
 type TeamMemberBanned struct {
-	ServerID        string          `json:"serverId"`
+	ServerID        ServerID        `json:"serverId"`
 	ServerMemberBan ServerMemberBan `json:"serverMemberBan"`
 }
 
 type TeamMemberUnbanned struct {
-	ServerID        string          `json:"serverId"`
+	ServerID        ServerID        `json:"serverId"`
 	ServerMemberBan ServerMemberBan `json:"serverMemberBan"`
 }
 
 type TeamMemberUpdated struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	UserInfo UserInfo `json:"userInfo"`
 }
 
 type TeamRolesUpdated struct {
-	ServerID      string       `json:"serverId"`
+	ServerID      ServerID     `json:"serverId"`
 	MemberRoleIds []MemberRole `json:"memberRoleIds"`
 }
 
+type RoleCreated struct {
+	ServerID ServerID `json:"serverId"`
+	Role     Role     `json:"role"`
+}
+
+type RoleUpdated struct {
+	ServerID ServerID `json:"serverId"`
+	Role     Role     `json:"role"`
+}
+
+type RoleDeleted struct {
+	ServerID ServerID `json:"serverId"`
+	Role     Role     `json:"role"`
+}
+
+type UserStatusCreated struct {
+	UserID     UserID     `json:"userId"`
+	UserStatus UserStatus `json:"userStatus"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+}
+
+type UserStatusDeleted struct {
+	UserID     UserID     `json:"userId"`
+	UserStatus UserStatus `json:"userStatus"`
+}
+
 type TeamChannelCreated struct {
-	ServerID string        `json:"serverId"`
+	ServerID ServerID      `json:"serverId"`
 	Channel  ServerChannel `json:"channel"`
 }
 
 type TeamChannelUpdated struct {
-	ServerID string        `json:"serverId"`
+	ServerID ServerID      `json:"serverId"`
 	Channel  ServerChannel `json:"channel"`
 }
 
 type TeamWebhookCreated struct {
-	ServerID string  `json:"serverId"`
-	Webhook  Webhook `json:"webhook"`
+	ServerID ServerID `json:"serverId"`
+	Webhook  Webhook  `json:"webhook"`
 }
 
 type TeamWebhookUpdated struct {
-	ServerID string  `json:"serverId"`
-	Webhook  Webhook `json:"webhook"`
+	ServerID ServerID `json:"serverId"`
+	Webhook  Webhook  `json:"webhook"`
 }
 
 type DocCreated struct {
-	ServerID string `json:"serverId"`
-	Doc      Doc    `json:"doc"`
+	ServerID ServerID `json:"serverId"`
+	Doc      Doc      `json:"doc"`
 }
 
 type DocUpdated struct {
-	ServerID string `json:"serverId"`
-	Doc      Doc    `json:"doc"`
+	ServerID ServerID `json:"serverId"`
+	Doc      Doc      `json:"doc"`
 }
 
 type DocDeleted struct {
-	ServerID string `json:"serverId"`
-	Doc      Doc    `json:"doc"`
+	ServerID ServerID `json:"serverId"`
+	Doc      Doc      `json:"doc"`
+}
+
+type DocCommentCreated struct {
+	ServerID   ServerID   `json:"serverId"`
+	DocComment DocComment `json:"docComment"`
+}
+
+type DocCommentUpdated struct {
+	ServerID   ServerID   `json:"serverId"`
+	DocComment DocComment `json:"docComment"`
+}
+
+type DocCommentDeleted struct {
+	ServerID   ServerID   `json:"serverId"`
+	DocComment DocComment `json:"docComment"`
 }
 
 type CalendarEventCreated struct {
-	ServerID      string        `json:"serverId"`
+	ServerID      ServerID      `json:"serverId"`
 	CalendarEvent CalendarEvent `json:"calendarEvent"`
 }
 
 type CalendarEventUpdated struct {
-	ServerID      string        `json:"serverId"`
+	ServerID      ServerID      `json:"serverId"`
 	CalendarEvent CalendarEvent `json:"calendarEvent"`
 }
 
 type CalendarEventDeleted struct {
-	ServerID      string        `json:"serverId"`
+	ServerID      ServerID      `json:"serverId"`
 	CalendarEvent CalendarEvent `json:"calendarEvent"`
 }
 
+type CalendarEventSeriesUpdated struct {
+	ServerID            ServerID            `json:"serverId"`
+	CalendarEventSeries CalendarEventSeries `json:"calendarEventSeries"`
+	// ID of the first updated event, when not the whole series was updated
+	CalendarEventID CalendarEventID `json:"calendarEventId,omitempty"`
+}
+
+type CalendarEventSeriesDeleted struct {
+	ServerID            ServerID            `json:"serverId"`
+	CalendarEventSeries CalendarEventSeries `json:"calendarEventSeries"`
+	// ID of the first deleted event, when not the whole series was deleted
+	CalendarEventID CalendarEventID `json:"calendarEventId,omitempty"`
+}
+
+type CalendarEventCommentCreated struct {
+	ServerID             ServerID             `json:"serverId"`
+	CalendarEventComment CalendarEventComment `json:"calendarEventComment"`
+}
+
+type CalendarEventCommentUpdated struct {
+	ServerID             ServerID             `json:"serverId"`
+	CalendarEventComment CalendarEventComment `json:"calendarEventComment"`
+}
+
+type CalendarEventCommentDeleted struct {
+	ServerID             ServerID             `json:"serverId"`
+	CalendarEventComment CalendarEventComment `json:"calendarEventComment"`
+}
+
+type AnnouncementCreated struct {
+	ServerID     ServerID     `json:"serverId"`
+	Announcement Announcement `json:"announcement"`
+}
+
+type AnnouncementUpdated struct {
+	ServerID     ServerID     `json:"serverId"`
+	Announcement Announcement `json:"announcement"`
+}
+
+type AnnouncementDeleted struct {
+	ServerID     ServerID     `json:"serverId"`
+	Announcement Announcement `json:"announcement"`
+}
+
+type AnnouncementCommentCreated struct {
+	ServerID            ServerID            `json:"serverId"`
+	AnnouncementComment AnnouncementComment `json:"announcementComment"`
+}
+
+type AnnouncementCommentUpdated struct {
+	ServerID            ServerID            `json:"serverId"`
+	AnnouncementComment AnnouncementComment `json:"announcementComment"`
+}
+
+type AnnouncementCommentDeleted struct {
+	ServerID            ServerID            `json:"serverId"`
+	AnnouncementComment AnnouncementComment `json:"announcementComment"`
+}
+
 type ListItemCreated struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	ListItem ListItem `json:"listItem"`
 }
 
 type ListItemUpdated struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	ListItem ListItem `json:"listItem"`
 }
 
 type ListItemDeleted struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	ListItem ListItem `json:"listItem"`
 }
 
 type ListItemCompleted struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	ListItem ListItem `json:"listItem"`
 }
 
 type ChannelMessageReactionCreated struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	Reaction Reaction `json:"reaction"`
 }
 
 type ChannelMessageReactionDeleted struct {
-	ServerID string   `json:"serverId"`
+	ServerID ServerID `json:"serverId"`
 	Reaction Reaction `json:"reaction"`
 }
 
+type ChannelMessageReactionManyDeleted struct {
+	ServerID  ServerID  `json:"serverId"`
+	ChannelID ChannelID `json:"channelId"`
+	MessageID MessageID `json:"messageId"`
+	DeletedBy UserID    `json:"deletedBy"`
+	Count     int       `json:"count"`
+	// Set when only the reactions of one emote were deleted
+	Emote *Emote `json:"emote,omitempty"`
+}
+
+type ForumTopicReactionCreated struct {
+	ServerID ServerID           `json:"serverId"`
+	Reaction ForumTopicReaction `json:"reaction"`
+}
+
+type ForumTopicReactionDeleted struct {
+	ServerID ServerID           `json:"serverId"`
+	Reaction ForumTopicReaction `json:"reaction"`
+}
+
+type ForumTopicCommentReactionCreated struct {
+	ServerID ServerID                  `json:"serverId"`
+	Reaction ForumTopicCommentReaction `json:"reaction"`
+}
+
+type ForumTopicCommentReactionDeleted struct {
+	ServerID ServerID                  `json:"serverId"`
+	Reaction ForumTopicCommentReaction `json:"reaction"`
+}
+
+type DocReactionCreated struct {
+	ServerID ServerID    `json:"serverId"`
+	Reaction DocReaction `json:"reaction"`
+}
+
+type DocReactionDeleted struct {
+	ServerID ServerID    `json:"serverId"`
+	Reaction DocReaction `json:"reaction"`
+}
+
+type DocCommentReactionCreated struct {
+	ServerID ServerID           `json:"serverId"`
+	Reaction DocCommentReaction `json:"reaction"`
+}
+
+type DocCommentReactionDeleted struct {
+	ServerID ServerID           `json:"serverId"`
+	Reaction DocCommentReaction `json:"reaction"`
+}
+
+type CalendarEventReactionCreated struct {
+	ServerID ServerID              `json:"serverId"`
+	Reaction CalendarEventReaction `json:"reaction"`
+}
+
+type CalendarEventReactionDeleted struct {
+	ServerID ServerID              `json:"serverId"`
+	Reaction CalendarEventReaction `json:"reaction"`
+}
+
+type CalendarEventCommentReactionCreated struct {
+	ServerID ServerID                     `json:"serverId"`
+	Reaction CalendarEventCommentReaction `json:"reaction"`
+}
+
+type CalendarEventCommentReactionDeleted struct {
+	ServerID ServerID                     `json:"serverId"`
+	Reaction CalendarEventCommentReaction `json:"reaction"`
+}
+
+type AnnouncementReactionCreated struct {
+	ServerID ServerID             `json:"serverId"`
+	Reaction AnnouncementReaction `json:"reaction"`
+}
+
+type AnnouncementReactionDeleted struct {
+	ServerID ServerID             `json:"serverId"`
+	Reaction AnnouncementReaction `json:"reaction"`
+}
+
+// Ready is the data for a Ready event.
+// It is built from the welcome message received when connecting to Guilded.
 type Ready struct {
 	LastMessageID       string  `json:"lastMessageId"`
 	HeartbeatIntervalMS int     `json:"heartbeatIntervalMs"`
@@ -174,31 +391,66 @@ type Ready struct {
 }
 
 type ForumTopicCreated struct {
-	ServerID   string     `json:"serverId"`
+	ServerID   ServerID   `json:"serverId"`
 	ForumTopic ForumTopic `json:"forumTopic"`
 }
 
 type ForumTopicUpdated struct {
-	ServerID   string     `json:"serverId"`
+	ServerID   ServerID   `json:"serverId"`
 	ForumTopic ForumTopic `json:"forumTopic"`
 }
 
 type ForumTopicDeleted struct {
-	ServerID   string     `json:"serverId"`
+	ServerID   ServerID   `json:"serverId"`
+	ForumTopic ForumTopic `json:"forumTopic"`
+}
+
+type ForumTopicPinned struct {
+	ServerID   ServerID   `json:"serverId"`
+	ForumTopic ForumTopic `json:"forumTopic"`
+}
+
+type ForumTopicUnpinned struct {
+	ServerID   ServerID   `json:"serverId"`
 	ForumTopic ForumTopic `json:"forumTopic"`
 }
 
+type ForumTopicLocked struct {
+	ServerID   ServerID   `json:"serverId"`
+	ForumTopic ForumTopic `json:"forumTopic"`
+}
+
+type ForumTopicUnlocked struct {
+	ServerID   ServerID   `json:"serverId"`
+	ForumTopic ForumTopic `json:"forumTopic"`
+}
+
+type ForumTopicCommentCreated struct {
+	ServerID          ServerID          `json:"serverId"`
+	ForumTopicComment ForumTopicComment `json:"forumTopicComment"`
+}
+
+type ForumTopicCommentUpdated struct {
+	ServerID          ServerID          `json:"serverId"`
+	ForumTopicComment ForumTopicComment `json:"forumTopicComment"`
+}
+
+type ForumTopicCommentDeleted struct {
+	ServerID          ServerID          `json:"serverId"`
+	ForumTopicComment ForumTopicComment `json:"forumTopicComment"`
+}
+
 type CalendarEventRsvpUpdated struct {
-	ServerID          string            `json:"serverId"`
+	ServerID          ServerID          `json:"serverId"`
 	CalendarEventRsvp CalendarEventRsvp `json:"calendarEventRsvp"`
 }
 
 type CalendarEventRsvpManyUpdated struct {
-	ServerID           string              `json:"serverId"`
+	ServerID           ServerID            `json:"serverId"`
 	CalendarEventRsvps []CalendarEventRsvp `json:"calendarEventRsvps"`
 }
 
 type CalendarEventRsvpDeleted struct {
-	ServerID          string            `json:"serverId"`
+	ServerID          ServerID          `json:"serverId"`
 	CalendarEventRsvp CalendarEventRsvp `json:"calendarEventRsvp"`
 }
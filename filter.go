@@ -0,0 +1,216 @@
+package guildrone
+
+// IDFilter is an allow and deny list of IDs.
+// An ID passes the filter if it is not denied and the allow list
+// is either empty or contains it.
+type IDFilter[T ~string] struct {
+	Allow []T
+	Deny  []T
+}
+
+// Allowed returns true if the ID passes the filter.
+func (f *IDFilter[T]) Allowed(id T) bool {
+	for _, d := range f.Deny {
+		if d == id {
+			return false
+		}
+	}
+
+	if len(f.Allow) == 0 {
+		return true
+	}
+
+	for _, a := range f.Allow {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
+
+// eventSource describes where a content event comes from.
+type eventSource struct {
+	ServerID  ServerID
+	ChannelID ChannelID
+	CreatedBy UserID
+	WebhookID WebhookID
+	IsPrivate bool
+}
+
+// contentEventSource returns the source of chat, topic, doc and list events,
+// including the reactions to them.
+// Returns false for any other event. The events not filtered are the
+// connection, member, role, status, channel, webhook, calendar event,
+// RSVP and announcement events; filter_test.go fails when an event is
+// added without being listed on either side.
+func contentEventSource(i interface{}) (eventSource, bool) {
+	switch t := i.(type) {
+	case *ChatMessageCreated:
+		return chatMessageSource(&t.Message), true
+	case *ChatMessageUpdated:
+		return chatMessageSource(&t.Message), true
+	case *ChatMessageDeleted:
+		return chatMessageSource(&t.Message), true
+	case *ChannelMessageReactionCreated:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ChannelMessageReactionDeleted:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ChannelMessageReactionManyDeleted:
+		return reactionSource(t.ServerID, t.ChannelID, ""), true
+	case *ForumTopicCreated:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicUpdated:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicDeleted:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicPinned:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicUnpinned:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicLocked:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicUnlocked:
+		return forumTopicSource(&t.ForumTopic), true
+	case *ForumTopicReactionCreated:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ForumTopicReactionDeleted:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ForumTopicCommentCreated:
+		return forumTopicCommentSource(t.ServerID, &t.ForumTopicComment), true
+	case *ForumTopicCommentUpdated:
+		return forumTopicCommentSource(t.ServerID, &t.ForumTopicComment), true
+	case *ForumTopicCommentDeleted:
+		return forumTopicCommentSource(t.ServerID, &t.ForumTopicComment), true
+	case *ForumTopicCommentReactionCreated:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ForumTopicCommentReactionDeleted:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *DocCreated:
+		return docSource(&t.Doc), true
+	case *DocUpdated:
+		return docSource(&t.Doc), true
+	case *DocDeleted:
+		return docSource(&t.Doc), true
+	case *DocCommentCreated:
+		return docCommentSource(t.ServerID, &t.DocComment), true
+	case *DocCommentUpdated:
+		return docCommentSource(t.ServerID, &t.DocComment), true
+	case *DocCommentDeleted:
+		return docCommentSource(t.ServerID, &t.DocComment), true
+	case *DocReactionCreated:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *DocReactionDeleted:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *DocCommentReactionCreated:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *DocCommentReactionDeleted:
+		return reactionSource(t.ServerID, t.Reaction.ChannelID, t.Reaction.CreatedBy), true
+	case *ListItemCreated:
+		return listItemSource(&t.ListItem), true
+	case *ListItemUpdated:
+		return listItemSource(&t.ListItem), true
+	case *ListItemDeleted:
+		return listItemSource(&t.ListItem), true
+	case *ListItemCompleted:
+		return listItemSource(&t.ListItem), true
+	}
+	return eventSource{}, false
+}
+
+func chatMessageSource(m *ChatMessage) eventSource {
+	return eventSource{
+		ServerID:  m.ServerID,
+		ChannelID: m.ChannelID,
+		CreatedBy: m.CreatedBy,
+		WebhookID: m.CreatedByWebhookId,
+		IsPrivate: m.IsPrivate,
+	}
+}
+
+func forumTopicSource(t *ForumTopic) eventSource {
+	return eventSource{
+		ServerID:  t.ServerID,
+		ChannelID: t.ChannelID,
+		CreatedBy: t.CreatedBy,
+		WebhookID: t.CreatedByWebhookId,
+	}
+}
+
+func forumTopicCommentSource(serverID ServerID, c *ForumTopicComment) eventSource {
+	return eventSource{
+		ServerID:  serverID,
+		ChannelID: c.ChannelID,
+		CreatedBy: c.CreatedBy,
+	}
+}
+
+func docSource(d *Doc) eventSource {
+	return eventSource{
+		ServerID:  d.ServerId,
+		ChannelID: d.ChannelId,
+		CreatedBy: d.CreatedBy,
+	}
+}
+
+func docCommentSource(serverID ServerID, c *DocComment) eventSource {
+	return eventSource{
+		ServerID:  serverID,
+		ChannelID: c.ChannelID,
+		CreatedBy: c.CreatedBy,
+	}
+}
+
+func reactionSource(serverID ServerID, channelID ChannelID, createdBy UserID) eventSource {
+	return eventSource{
+		ServerID:  serverID,
+		ChannelID: channelID,
+		CreatedBy: createdBy,
+	}
+}
+
+func listItemSource(l *ListItem) eventSource {
+	return eventSource{
+		ServerID:  l.ServerID,
+		ChannelID: l.ChannelID,
+		CreatedBy: l.CreatedBy,
+		WebhookID: l.CreatedByWebhookId,
+	}
+}
+
+// ignoreEvent returns true if the session filters rule out the event,
+// in which case no handlers are called for it.
+func (s *Session) ignoreEvent(i interface{}) bool {
+	src, ok := contentEventSource(i)
+	if !ok {
+		return false
+	}
+
+	// Deletions and reactions of the bot's own messages are still dispatched,
+	// so that an audit log sees them.
+	switch i.(type) {
+	case *ChatMessageCreated, *ChatMessageUpdated:
+		if s.ShouldIgnoreOwnMessages {
+			if u := s.CurrentUser(); u != nil && src.CreatedBy == u.ID {
+				return true
+			}
+		}
+	}
+
+	if s.ShouldIgnoreWebhookMessages && src.WebhookID != "" {
+		return true
+	}
+
+	if s.ShouldIgnorePrivateMessages && src.IsPrivate {
+		return true
+	}
+
+	if src.ServerID != "" && !s.ServerFilter.Allowed(src.ServerID) {
+		return true
+	}
+
+	if src.ChannelID != "" && !s.ChannelFilter.Allowed(src.ChannelID) {
+		return true
+	}
+
+	return false
+}
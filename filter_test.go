@@ -0,0 +1,118 @@
+package guildrone
+
+import "testing"
+
+// unfilteredEvents are the events contentEventSource deliberately ignores.
+var unfilteredEvents = map[string]bool{
+	"AnnouncementCommentCreated":          true,
+	"AnnouncementCommentDeleted":          true,
+	"AnnouncementCommentUpdated":          true,
+	"AnnouncementCreated":                 true,
+	"AnnouncementDeleted":                 true,
+	"AnnouncementReactionCreated":         true,
+	"AnnouncementReactionDeleted":         true,
+	"AnnouncementUpdated":                 true,
+	"CalendarEventCommentCreated":         true,
+	"CalendarEventCommentDeleted":         true,
+	"CalendarEventCommentReactionCreated": true,
+	"CalendarEventCommentReactionDeleted": true,
+	"CalendarEventCommentUpdated":         true,
+	"CalendarEventCreated":                true,
+	"CalendarEventDeleted":                true,
+	"CalendarEventReactionCreated":        true,
+	"CalendarEventReactionDeleted":        true,
+	"CalendarEventRsvpDeleted":            true,
+	"CalendarEventRsvpManyUpdated":        true,
+	"CalendarEventRsvpUpdated":            true,
+	"CalendarEventSeriesDeleted":          true,
+	"CalendarEventSeriesUpdated":          true,
+	"CalendarEventUpdated":                true,
+	"Ready":                               true,
+	"RoleCreated":                         true,
+	"RoleDeleted":                         true,
+	"RoleUpdated":                         true,
+	"TeamChannelCreated":                  true,
+	"TeamChannelUpdated":                  true,
+	"TeamMemberBanned":                    true,
+	"TeamMemberJoined":                    true,
+	"TeamMemberRemoved":                   true,
+	"TeamMemberUnbanned":                  true,
+	"TeamMemberUpdated":                   true,
+	"TeamRolesUpdated":                    true,
+	"TeamWebhookCreated":                  true,
+	"TeamWebhookUpdated":                  true,
+	"UserStatusCreated":                   true,
+	"UserStatusDeleted":                   true,
+}
+
+func TestContentEventSourceCoversEvents(t *testing.T) {
+	for name, p := range registeredInterfaceProviders {
+		_, ok := contentEventSource(p.New())
+		if ok == unfilteredEvents[name] {
+			t.Errorf("%s: filtered = %v, listed as unfiltered = %v", name, ok, unfilteredEvents[name])
+		}
+	}
+}
+
+func TestIDFilterAllowed(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter IDFilter[ServerID]
+		id     ServerID
+		want   bool
+	}{
+		{"empty", IDFilter[ServerID]{}, "a", true},
+		{"allowed", IDFilter[ServerID]{Allow: []ServerID{"a", "b"}}, "b", true},
+		{"not allowed", IDFilter[ServerID]{Allow: []ServerID{"a"}}, "b", false},
+		{"denied", IDFilter[ServerID]{Deny: []ServerID{"a"}}, "a", false},
+		{"not denied", IDFilter[ServerID]{Deny: []ServerID{"a"}}, "b", true},
+		{"deny wins", IDFilter[ServerID]{Allow: []ServerID{"a"}, Deny: []ServerID{"a"}}, "a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Allowed(tt.id); got != tt.want {
+				t.Errorf("Allowed(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreEvent(t *testing.T) {
+	own := &ChatMessageCreated{Message: ChatMessage{ServerID: "s1", ChannelID: "c1", CreatedBy: "bot"}}
+	other := &ChatMessageCreated{Message: ChatMessage{ServerID: "s1", ChannelID: "c1", CreatedBy: "u1"}}
+	webhook := &ChatMessageCreated{Message: ChatMessage{ServerID: "s1", ChannelID: "c1", CreatedByWebhookId: "w1"}}
+	private := &ChatMessageCreated{Message: ChatMessage{ServerID: "s1", ChannelID: "c1", IsPrivate: true}}
+	ownDeleted := &ChatMessageDeleted{Message: ChatMessage{ServerID: "s1", ChannelID: "c1", CreatedBy: "bot"}}
+	reaction := &ForumTopicReactionCreated{ServerID: "s2", Reaction: ForumTopicReaction{ChannelID: "c2", CreatedBy: "bot"}}
+
+	tests := []struct {
+		name    string
+		session *Session
+		event   interface{}
+		want    bool
+	}{
+		{"no filters", &Session{}, own, false},
+		{"own message", &Session{ShouldIgnoreOwnMessages: true}, own, true},
+		{"other message", &Session{ShouldIgnoreOwnMessages: true}, other, false},
+		{"own message deleted", &Session{ShouldIgnoreOwnMessages: true}, ownDeleted, false},
+		{"own reaction", &Session{ShouldIgnoreOwnMessages: true}, reaction, false},
+		{"webhook", &Session{ShouldIgnoreWebhookMessages: true}, webhook, true},
+		{"private", &Session{ShouldIgnorePrivateMessages: true}, private, true},
+		{"public", &Session{ShouldIgnorePrivateMessages: true}, other, false},
+		{"server denied", &Session{ServerFilter: IDFilter[ServerID]{Deny: []ServerID{"s1"}}}, other, true},
+		{"server not allowed", &Session{ServerFilter: IDFilter[ServerID]{Allow: []ServerID{"s1"}}}, reaction, true},
+		{"channel allowed", &Session{ChannelFilter: IDFilter[ChannelID]{Allow: []ChannelID{"c1"}}}, other, false},
+		{"channel denied", &Session{ChannelFilter: IDFilter[ChannelID]{Deny: []ChannelID{"c2"}}}, reaction, true},
+		{"other event", &Session{ServerFilter: IDFilter[ServerID]{Deny: []ServerID{"s1"}}}, &TeamMemberJoined{ServerID: "s1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.session.user = &BotUser{ID: "bot"}
+			if got := tt.session.ignoreEvent(tt.event); got != tt.want {
+				t.Errorf("ignoreEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,6 +16,9 @@ func New(token string) (s *Session, err error) {
 		ShouldReplayEventsOnReconnect: true,
 		ShouldRetryOnRateLimit:        true,
 		MaxRestRetries:                3,
+		MaxUploadSize:                 DefaultMaxUploadSize,
+		StateEnabled:                  true,
+		State:                         NewState(),
 		Client:                        &http.Client{Timeout: (20 * time.Second)},
 		UserAgent:                     "GuildedBot (https://github.com/FlameInTheDark/guildrone, v" + VERSION + ")",
 		LastHeartbeatAck:              time.Now().UTC(),
@@ -0,0 +1,161 @@
+package guildrone
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Guilded IDs get a distinct type per kind of object, so passing the ID of
+// one kind of object where another is expected does not compile.
+// String IDs are the IDs Guilded sends as strings, integer IDs the ones it
+// sends as numbers. Integer IDs also accept numbers sent as JSON strings.
+
+// ServerID is the ID of a server.
+type ServerID string
+
+// ChannelID is the ID of a channel.
+type ChannelID string
+
+// UserID is the ID of a user or a bot user.
+type UserID string
+
+// MessageID is the ID of a chat message.
+type MessageID string
+
+// WebhookID is the ID of a webhook.
+type WebhookID string
+
+// GroupID is the ID of a group.
+type GroupID string
+
+// ListItemID is the ID of a list item.
+type ListItemID string
+
+// AnnouncementID is the ID of an announcement.
+type AnnouncementID string
+
+// CalendarEventSeriesID is the ID of a calendar event series.
+type CalendarEventSeriesID string
+
+// RoleID is the ID of a role.
+type RoleID int
+
+// CategoryID is the ID of a channel category.
+type CategoryID int
+
+// EmoteID is the ID of an emote.
+type EmoteID int
+
+// ForumTopicID is the ID of a forum topic.
+type ForumTopicID int
+
+// ForumTopicCommentID is the ID of a forum topic comment.
+type ForumTopicCommentID int
+
+// DocID is the ID of a doc.
+type DocID int
+
+// DocCommentID is the ID of a doc comment.
+type DocCommentID int
+
+// CalendarEventID is the ID of a calendar event.
+type CalendarEventID int
+
+// CalendarEventCommentID is the ID of a calendar event comment.
+type CalendarEventCommentID int
+
+// AnnouncementCommentID is the ID of an announcement comment.
+type AnnouncementCommentID int
+
+func (id ServerID) String() string              { return string(id) }
+func (id ChannelID) String() string             { return string(id) }
+func (id UserID) String() string                { return string(id) }
+func (id MessageID) String() string             { return string(id) }
+func (id WebhookID) String() string             { return string(id) }
+func (id GroupID) String() string               { return string(id) }
+func (id ListItemID) String() string            { return string(id) }
+func (id AnnouncementID) String() string        { return string(id) }
+func (id CalendarEventSeriesID) String() string { return string(id) }
+
+func (id RoleID) String() string                 { return strconv.Itoa(int(id)) }
+func (id CategoryID) String() string             { return strconv.Itoa(int(id)) }
+func (id EmoteID) String() string                { return strconv.Itoa(int(id)) }
+func (id ForumTopicID) String() string           { return strconv.Itoa(int(id)) }
+func (id ForumTopicCommentID) String() string    { return strconv.Itoa(int(id)) }
+func (id DocID) String() string                  { return strconv.Itoa(int(id)) }
+func (id DocCommentID) String() string           { return strconv.Itoa(int(id)) }
+func (id CalendarEventID) String() string        { return strconv.Itoa(int(id)) }
+func (id CalendarEventCommentID) String() string { return strconv.Itoa(int(id)) }
+func (id AnnouncementCommentID) String() string  { return strconv.Itoa(int(id)) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *RoleID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *CategoryID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *EmoteID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *ForumTopicID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *ForumTopicCommentID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *DocID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *DocCommentID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *CalendarEventID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *CalendarEventCommentID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *AnnouncementCommentID) UnmarshalJSON(b []byte) error {
+	return unmarshalIntID(b, (*int)(id))
+}
+
+// unmarshalIntID decodes an integer ID sent either as a JSON number or string.
+func unmarshalIntID(b []byte, id *int) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*id = 0
+			return nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		*id = n
+		return nil
+	}
+
+	return json.Unmarshal(b, id)
+}
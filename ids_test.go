@@ -0,0 +1,109 @@
+package guildrone
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestIntIDUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    RoleID
+		wantErr bool
+	}{
+		{"number", `42`, 42, false},
+		{"string", `"42"`, 42, false},
+		{"empty string", `""`, 0, false},
+		{"null", `null`, 7, false},
+		{"negative string", `"-3"`, -3, false},
+		{"invalid string", `"abc"`, 7, true},
+		{"float", `1.5`, 7, true},
+		{"float string", `"1.5"`, 7, true},
+		{"bool", `true`, 7, true},
+		{"unterminated string", `"42`, 7, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := RoleID(7)
+			err := id.UnmarshalJSON([]byte(tt.json))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON(%s) error = %v, want error %v", tt.json, err, tt.wantErr)
+			}
+			if id != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %d, want %d", tt.json, id, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntIDsAcceptStrings(t *testing.T) {
+	ids := []interface{}{
+		new(RoleID),
+		new(CategoryID),
+		new(EmoteID),
+		new(ForumTopicID),
+		new(ForumTopicCommentID),
+		new(DocID),
+		new(DocCommentID),
+		new(CalendarEventID),
+		new(CalendarEventCommentID),
+		new(AnnouncementCommentID),
+	}
+
+	for _, id := range ids {
+		name := reflect.TypeOf(id).Elem().Name()
+		t.Run(name, func(t *testing.T) {
+			for _, in := range []string{`12`, `"12"`} {
+				if err := json.Unmarshal([]byte(in), id); err != nil {
+					t.Fatalf("Unmarshal(%s) error = %v", in, err)
+				}
+				if got := fmt.Sprint(reflect.ValueOf(id).Elem().Interface()); got != "12" {
+					t.Errorf("Unmarshal(%s) = %s, want 12", in, got)
+				}
+			}
+		})
+	}
+}
+
+func TestIDsJSON(t *testing.T) {
+	type ids struct {
+		ServerID  ServerID  `json:"serverId"`
+		UserID    UserID    `json:"userId,omitempty"`
+		RoleID    RoleID    `json:"roleId"`
+		EmoteID   EmoteID   `json:"emoteId,omitempty"`
+		MessageID MessageID `json:"messageId"`
+	}
+
+	tests := []struct {
+		name string
+		ids  ids
+		json string
+	}{
+		{"all set", ids{ServerID: "s1", UserID: "u1", RoleID: 3, EmoteID: 90000001, MessageID: "m1"}, `{"serverId":"s1","userId":"u1","roleId":3,"emoteId":90000001,"messageId":"m1"}`},
+		{"zero values", ids{}, `{"serverId":"","roleId":0,"messageId":""}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.ids)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(b) != tt.json {
+				t.Errorf("Marshal() = %s, want %s", b, tt.json)
+			}
+
+			var got ids
+			if err = json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got != tt.ids {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.ids)
+			}
+		})
+	}
+}
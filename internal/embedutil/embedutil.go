@@ -0,0 +1,26 @@
+// Package embedutil holds the chat embed helpers shared by the
+// guildrone subpackages.
+package embedutil
+
+// Embed colors, by severity.
+const (
+	ColorInfo    = 0x3498db
+	ColorWarning = 0xf1c40f
+	ColorDanger  = 0xe74c3c
+	ColorSuccess = 0x2ecc71
+)
+
+// Embed text limits, in runes.
+const (
+	MaxDescription = 2048
+	MaxFieldValue  = 1024
+)
+
+// Truncate shortens s to at most n runes, ending it with an ellipsis if cut.
+func Truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,30 @@
+// Package fileutil holds the file helpers shared by the stores of the
+// guildrone subpackages.
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteAtomic replaces the file at path with data.
+// The data is written and synced to a temporary file in the same
+// directory which is then renamed over path, so a crash leaves either
+// the old or the new content.
+func WriteAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if err2 := tmp.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
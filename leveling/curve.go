@@ -0,0 +1,78 @@
+package leveling
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxLevel is the highest level LevelFor returns.
+const MaxLevel = 1000
+
+// ErrInvalidCurve is returned when setting a curve not starting at 0 or
+// not strictly increasing.
+var ErrInvalidCurve = errors.New("invalid level curve")
+
+// Curve maps total XP to levels.
+type Curve interface {
+	// XPFor returns the total XP needed to reach the level.
+	// It must grow with the level, and XPFor(0) must be 0.
+	XPFor(level int) int
+}
+
+// ValidateCurve checks that XPFor(0) is 0 and that XPFor is strictly
+// increasing up to MaxLevel.
+func ValidateCurve(c Curve) error {
+	if c == nil {
+		return fmt.Errorf("%w: nil curve", ErrInvalidCurve)
+	}
+	prev := c.XPFor(0)
+	if prev != 0 {
+		return fmt.Errorf("%w: level 0 needs %d XP", ErrInvalidCurve, prev)
+	}
+	for level := 1; level <= MaxLevel; level++ {
+		xp := c.XPFor(level)
+		if xp <= prev {
+			return fmt.Errorf("%w: level %d needs %d XP, level %d needs %d", ErrInvalidCurve, level, xp, level-1, prev)
+		}
+		prev = xp
+	}
+	return nil
+}
+
+// LevelFor returns the level reached with the total XP on the curve,
+// at most MaxLevel.
+func LevelFor(c Curve, total int) int {
+	level := 0
+	for level < MaxLevel && c.XPFor(level+1) <= total {
+		level++
+	}
+	return level
+}
+
+// LinearCurve needs the same amount of XP for every level.
+type LinearCurve struct {
+	PerLevel int
+}
+
+// XPFor implements Curve.
+func (c LinearCurve) XPFor(level int) int {
+	return c.PerLevel * level
+}
+
+// PolynomialCurve needs A*n² + B*n + C XP to go from level n to level n+1.
+type PolynomialCurve struct {
+	A, B, C int
+}
+
+// XPFor implements Curve.
+func (c PolynomialCurve) XPFor(level int) int {
+	total := 0
+	for n := 0; n < level; n++ {
+		total += c.A*n*n + c.B*n + c.C
+	}
+	return total
+}
+
+// DefaultCurve is the curve used until Leveling.SetCurve is called.
+// Level 1 needs 100 XP, level 10 about 4700 and level 50 about 270000.
+var DefaultCurve Curve = PolynomialCurve{A: 5, B: 50, C: 100}
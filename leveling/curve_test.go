@@ -0,0 +1,65 @@
+package leveling
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLevelFor(t *testing.T) {
+	tests := []struct {
+		name  string
+		curve Curve
+		total int
+		want  int
+	}{
+		{"linear zero", LinearCurve{PerLevel: 100}, 0, 0},
+		{"linear below", LinearCurve{PerLevel: 100}, 99, 0},
+		{"linear exact", LinearCurve{PerLevel: 100}, 100, 1},
+		{"linear many", LinearCurve{PerLevel: 100}, 1050, 10},
+		{"default first", DefaultCurve, 100, 1},
+		{"default second", DefaultCurve, 254, 1},
+		{"default second exact", DefaultCurve, 255, 2},
+		{"capped", LinearCurve{PerLevel: 1}, MaxLevel * 2, MaxLevel},
+		{"flat curve", LinearCurve{}, 10, MaxLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LevelFor(tt.curve, tt.total); got != tt.want {
+				t.Errorf("LevelFor(%d) = %d, want %d", tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+type offsetCurve struct{}
+
+func (offsetCurve) XPFor(level int) int { return 10 + level }
+
+func TestValidateCurve(t *testing.T) {
+	tests := []struct {
+		name    string
+		curve   Curve
+		wantErr bool
+	}{
+		{"default", DefaultCurve, false},
+		{"linear", LinearCurve{PerLevel: 50}, false},
+		{"nil", nil, true},
+		{"zero linear", LinearCurve{}, true},
+		{"zero polynomial", PolynomialCurve{}, true},
+		{"decreasing", LinearCurve{PerLevel: -1}, true},
+		{"not starting at zero", offsetCurve{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCurve(tt.curve)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCurve() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidCurve) {
+				t.Errorf("ValidateCurve() error = %v, want ErrInvalidCurve", err)
+			}
+		})
+	}
+}
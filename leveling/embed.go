@@ -0,0 +1,60 @@
+package leveling
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/embedutil"
+)
+
+// Leaderboard returns the n members of a server with the most XP.
+// serverID : The ID of a Server.
+// n        : The number of members.
+func (l *Leveling) Leaderboard(serverID guildrone.ServerID, n int) ([]Standing, error) {
+	return l.store.Top(serverID, n)
+}
+
+// LeaderboardEmbed renders the n members of a server with the most XP as an embed.
+// serverID : The ID of a Server.
+// n        : The number of members.
+func (l *Leveling) LeaderboardEmbed(serverID guildrone.ServerID, n int) (guildrone.ChatEmbed, error) {
+	standings, err := l.Leaderboard(serverID, n)
+	if err != nil {
+		return guildrone.ChatEmbed{}, err
+	}
+
+	var b strings.Builder
+	for i, s := range standings {
+		line := fmt.Sprintf("**%d.** <@%s> level %d (%d XP)\n", i+1, s.UserID, l.progress(s.Total).Level, s.Total)
+		if b.Len()+len(line) > embedutil.MaxDescription {
+			break
+		}
+		b.WriteString(line)
+	}
+	if len(standings) == 0 {
+		b.WriteString("Nobody has earned XP yet.")
+	}
+
+	return guildrone.ChatEmbed{
+		Title:       "Leaderboard",
+		Description: b.String(),
+		Color:       embedutil.ColorWarning,
+	}, nil
+}
+
+// ProgressEmbed renders the XP and level of a member as an embed.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (l *Leveling) ProgressEmbed(serverID guildrone.ServerID, userID guildrone.UserID) (guildrone.ChatEmbed, error) {
+	p, err := l.Progress(serverID, userID)
+	if err != nil {
+		return guildrone.ChatEmbed{}, err
+	}
+
+	return guildrone.ChatEmbed{
+		Title:       "Level " + fmt.Sprint(p.Level),
+		Description: fmt.Sprintf("<@%s> has %d XP, %d more to reach level %d.", userID, p.Total, p.Next-p.Total, p.Level+1),
+		Color:       embedutil.ColorWarning,
+	}, nil
+}
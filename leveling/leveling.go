@@ -0,0 +1,379 @@
+// Package leveling awards XP to members for chatting and turns it into
+// levels and role rewards.
+//
+// Guilded only returns the new XP total of a member when awarding XP, so
+// totals are tracked in a Store to compute levels and leaderboards:
+//
+//	l := leveling.New(s, leveling.NewMemoryStore())
+//	l.ChannelMultipliers = map[guildrone.ChannelID]float64{spamChannel: 0}
+//	l.Rewards = []leveling.Reward{{Level: 5, RoleID: 1234}, {Level: 10, RoleID: 5678}}
+//	l.OnLevelUp = func(u leveling.LevelUp) { ... }
+//	defer l.Start()()
+package leveling
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Reward is a role given to members reaching a level.
+type Reward struct {
+	Level  int
+	RoleID guildrone.RoleID
+}
+
+// Progress is the XP and level of a member.
+type Progress struct {
+	Total int
+	Level int
+
+	// Total XP needed for the next level
+	Next int
+}
+
+// LevelUp is a member reaching a higher level.
+type LevelUp struct {
+	ServerID  guildrone.ServerID
+	UserID    guildrone.UserID
+	ChannelID guildrone.ChannelID // Channel of the message that levelled up, if any
+	OldLevel  int
+	Progress  Progress
+	Rewards   []guildrone.RoleID // Roles given
+}
+
+type memberKey struct {
+	serverID guildrone.ServerID
+	userID   guildrone.UserID
+}
+
+// memberLock serializes the XP updates of a member.
+type memberLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Leveling awards XP for chat messages.
+type Leveling struct {
+	sync.Mutex
+
+	session   *guildrone.Session
+	store     Store
+	cooldowns map[memberKey]time.Time
+	members   map[memberKey]*memberLock
+	curve     Curve
+
+	// Range of the XP awarded per message.
+	MinXP int
+	MaxXP int
+
+	// Time before a member is awarded XP for another message.
+	Cooldown time.Duration
+
+	// XP multiplier per channel, 1 for channels not listed.
+	// A multiplier of 0 disables XP in the channel.
+	ChannelMultipliers map[guildrone.ChannelID]float64
+
+	// Roles given at levels.
+	Rewards []Reward
+
+	// Whether members keep the rewards of lower levels when given a new one.
+	StackRewards bool
+
+	// Called when a member levels up, ignored if nil.
+	OnLevelUp func(u LevelUp)
+
+	// Called with the errors of awarding XP and giving rewards, ignored if nil.
+	OnError func(err error)
+}
+
+// New creates a Leveling awarding XP through the session and keeping totals in the store.
+func New(s *guildrone.Session, store Store) *Leveling {
+	return &Leveling{
+		session:      s,
+		store:        store,
+		cooldowns:    map[memberKey]time.Time{},
+		members:      map[memberKey]*memberLock{},
+		curve:        DefaultCurve,
+		MinXP:        15,
+		MaxXP:        25,
+		Cooldown:     time.Minute,
+		StackRewards: true,
+	}
+}
+
+// Store returns the store totals are kept in.
+func (l *Leveling) Store() Store {
+	return l.store
+}
+
+// Curve returns the curve of the levels, DefaultCurve unless set with SetCurve.
+func (l *Leveling) Curve() Curve {
+	l.Lock()
+	defer l.Unlock()
+	return l.curve
+}
+
+// SetCurve sets the curve of the levels.
+// It returns an error wrapping ErrInvalidCurve if the curve is not valid.
+func (l *Leveling) SetCurve(c Curve) error {
+	if err := ValidateCurve(c); err != nil {
+		return err
+	}
+	l.Lock()
+	l.curve = c
+	l.Unlock()
+	return nil
+}
+
+// Start adds the event handlers of the leveling to the session.
+// It returns a function removing them.
+func (l *Leveling) Start() func() {
+	removers := []func(){
+		l.session.AddHandler(l.onChatMessageCreated),
+	}
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+	}
+}
+
+func (l *Leveling) onChatMessageCreated(s *guildrone.Session, m *guildrone.ChatMessageCreated) {
+	msg := &m.Message
+	if msg.ServerID == "" || msg.CreatedByWebhookId != "" || msg.Type == guildrone.MessageTypeSystem {
+		return
+	}
+
+	amount, ok := l.messageXP(msg)
+	if !ok {
+		return
+	}
+
+	_, err := l.award(msg.ServerID, msg.CreatedBy, msg.ChannelID, amount)
+	l.report(err)
+}
+
+// messageXP returns the XP awarded for a message, or false if none is
+// because of the cooldown or the channel multiplier.
+func (l *Leveling) messageXP(msg *guildrone.ChatMessage) (int, bool) {
+	if u := l.session.CurrentUser(); u != nil && msg.CreatedBy == u.ID {
+		return 0, false
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	multiplier := 1.0
+	if m, ok := l.ChannelMultipliers[msg.ChannelID]; ok {
+		multiplier = m
+	}
+	if multiplier <= 0 {
+		return 0, false
+	}
+
+	now := time.Now()
+	key := memberKey{msg.ServerID, msg.CreatedBy}
+	if last, ok := l.cooldowns[key]; ok && now.Sub(last) < l.Cooldown {
+		return 0, false
+	}
+	l.cooldowns[key] = now
+	l.pruneCooldowns(now)
+
+	amount := l.MinXP
+	if l.MaxXP > l.MinXP {
+		amount += rand.Intn(l.MaxXP - l.MinXP + 1)
+	}
+	amount = int(math.Round(float64(amount) * multiplier))
+	return amount, amount > 0
+}
+
+// pruneCooldowns drops the expired cooldowns once there are many of them,
+// l must be locked.
+func (l *Leveling) pruneCooldowns(now time.Time) {
+	if len(l.cooldowns) < 10000 {
+		return
+	}
+	for key, last := range l.cooldowns {
+		if now.Sub(last) >= l.Cooldown {
+			delete(l.cooldowns, key)
+		}
+	}
+}
+
+// Award awards XP to a member, updates the stored total and gives the
+// rewards of the levels reached.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+// amount   : The amount of XP to award.
+func (l *Leveling) Award(serverID guildrone.ServerID, userID guildrone.UserID, amount int) (Progress, error) {
+	return l.award(serverID, userID, "", amount)
+}
+
+func (l *Leveling) award(serverID guildrone.ServerID, userID guildrone.UserID, channelID guildrone.ChannelID, amount int) (Progress, error) {
+	unlock := l.lockMember(serverID, userID)
+	old, known, err := l.store.Total(serverID, userID)
+	if err != nil {
+		unlock()
+		return Progress{}, err
+	}
+
+	total, err := l.session.ServerMemberXPAward(serverID, userID, amount)
+	if err == nil {
+		err = l.store.SetTotal(serverID, userID, total)
+	}
+	unlock()
+	if err != nil {
+		return Progress{}, err
+	}
+	if !known {
+		old = total - amount
+	}
+
+	return l.update(serverID, userID, channelID, old, total), nil
+}
+
+// Set sets the XP total of a member, updates the stored total and gives
+// the rewards of the levels reached.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+// total    : The XP total.
+func (l *Leveling) Set(serverID guildrone.ServerID, userID guildrone.UserID, total int) (Progress, error) {
+	unlock := l.lockMember(serverID, userID)
+	old, _, err := l.store.Total(serverID, userID)
+	if err != nil {
+		unlock()
+		return Progress{}, err
+	}
+
+	total, err = l.session.ServerMemberXPSet(serverID, userID, total)
+	if err == nil {
+		err = l.store.SetTotal(serverID, userID, total)
+	}
+	unlock()
+	if err != nil {
+		return Progress{}, err
+	}
+
+	return l.update(serverID, userID, "", old, total), nil
+}
+
+// lockMember serializes the XP updates of a member, so that concurrent
+// awards read the total stored by the previous one and none is lost.
+// It returns the function unlocking the member.
+func (l *Leveling) lockMember(serverID guildrone.ServerID, userID guildrone.UserID) func() {
+	key := memberKey{serverID, userID}
+	l.Lock()
+	ml, ok := l.members[key]
+	if !ok {
+		ml = &memberLock{}
+		l.members[key] = ml
+	}
+	ml.refs++
+	l.Unlock()
+
+	ml.Lock()
+	return func() {
+		ml.Unlock()
+		l.Lock()
+		if ml.refs--; ml.refs == 0 {
+			delete(l.members, key)
+		}
+		l.Unlock()
+	}
+}
+
+// Progress returns the stored XP and level of a member.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (l *Leveling) Progress(serverID guildrone.ServerID, userID guildrone.UserID) (Progress, error) {
+	total, _, err := l.store.Total(serverID, userID)
+	if err != nil {
+		return Progress{}, err
+	}
+	return l.progress(total), nil
+}
+
+func (l *Leveling) progress(total int) Progress {
+	curve := l.Curve()
+	level := LevelFor(curve, total)
+	return Progress{Total: total, Level: level, Next: curve.XPFor(level + 1)}
+}
+
+// update handles the level up from old to total, once the new total is stored.
+func (l *Leveling) update(serverID guildrone.ServerID, userID guildrone.UserID, channelID guildrone.ChannelID, old, total int) Progress {
+	p := l.progress(total)
+	oldLevel := l.progress(old).Level
+	if p.Level <= oldLevel {
+		return p
+	}
+
+	up := LevelUp{
+		ServerID:  serverID,
+		UserID:    userID,
+		ChannelID: channelID,
+		OldLevel:  oldLevel,
+		Progress:  p,
+		Rewards:   l.giveRewards(serverID, userID, oldLevel, p.Level),
+	}
+
+	l.Lock()
+	onLevelUp := l.OnLevelUp
+	l.Unlock()
+	if onLevelUp != nil {
+		onLevelUp(up)
+	}
+	return p
+}
+
+// giveRewards gives the rewards of the levels above from and up to to,
+// and takes the lower ones back unless rewards stack.
+// It returns the roles given.
+func (l *Leveling) giveRewards(serverID guildrone.ServerID, userID guildrone.UserID, from, to int) []guildrone.RoleID {
+	l.Lock()
+	rewards := append([]Reward(nil), l.Rewards...)
+	stack := l.StackRewards
+	l.Unlock()
+
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Level < rewards[j].Level
+	})
+
+	var given []guildrone.RoleID
+	for _, r := range rewards {
+		if r.Level > from && r.Level <= to {
+			if err := l.session.ServerMemberRoleAdd(serverID, userID, r.RoleID); err != nil {
+				l.report(err)
+				continue
+			}
+			given = append(given, r.RoleID)
+		}
+	}
+
+	if !stack && len(given) > 0 {
+		keep := given[len(given)-1]
+		for _, r := range rewards {
+			if r.Level <= to && r.RoleID != keep {
+				l.report(l.session.ServerMemberRoleRemove(serverID, userID, r.RoleID))
+			}
+		}
+	}
+	return given
+}
+
+func (l *Leveling) report(err error) {
+	if err == nil {
+		return
+	}
+
+	l.Lock()
+	onError := l.OnError
+	l.Unlock()
+	if onError != nil {
+		onError(err)
+	}
+}
@@ -0,0 +1,186 @@
+package leveling
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// xpTransport keeps the XP total of a member like Guilded and records
+// the role changes requested, "+10" for giving role 10 and "-10" for
+// taking it back.
+type xpTransport struct {
+	mu      sync.Mutex
+	total   int
+	changes []string
+}
+
+func (x *xpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	x.mu.Lock()
+	body := ""
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/xp"):
+		var update struct {
+			Amount int `json:"amount"`
+			Total  int `json:"total"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&update); err != nil {
+			x.mu.Unlock()
+			return nil, err
+		}
+		if req.Method == "PUT" {
+			x.total = update.Total
+		} else {
+			x.total += update.Amount
+		}
+		body = fmt.Sprintf(`{"total":%d}`, x.total)
+	case strings.Contains(req.URL.Path, "/roles/"):
+		op := "+"
+		if req.Method == "DELETE" {
+			op = "-"
+		}
+		x.changes = append(x.changes, op+path.Base(req.URL.Path))
+	}
+	delay := time.Duration(x.total%3) * time.Millisecond
+	x.mu.Unlock()
+
+	// Answers arrive out of order, like concurrent requests to Guilded.
+	time.Sleep(delay)
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+		Request:    req,
+	}, nil
+}
+
+func newTestLeveling(total int) (*Leveling, *xpTransport) {
+	transport := &xpTransport{total: total}
+	l := New(&guildrone.Session{Client: &http.Client{Transport: transport}}, NewMemoryStore())
+	_ = l.SetCurve(LinearCurve{PerLevel: 100})
+	l.Rewards = []Reward{{Level: 3, RoleID: 30}, {Level: 1, RoleID: 10}, {Level: 2, RoleID: 20}}
+	return l, transport
+}
+
+func TestAward(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  int
+		known   bool
+		remote  int
+		amount  int
+		stack   bool
+		want    Progress
+		wantUp  int // Old level of the level up, -1 if none
+		changes []string
+	}{
+		{"no level up", 50, true, 50, 20, true, Progress{70, 0, 100}, -1, nil},
+		{"level up", 90, true, 90, 20, true, Progress{110, 1, 200}, 0, []string{"+10"}},
+		{"several levels stacked", 50, true, 50, 200, true, Progress{250, 2, 300}, 0, []string{"+10", "+20"}},
+		{"several levels not stacked", 50, true, 50, 200, false, Progress{250, 2, 300}, 0, []string{"+10", "+20", "-10"}},
+		{"unknown total", 0, false, 150, 100, true, Progress{250, 2, 300}, 1, []string{"+20"}},
+		{"unknown total not stacked", 0, false, 150, 100, false, Progress{250, 2, 300}, 1, []string{"+20", "-10"}},
+		{"stored total behind", 0, true, 150, 100, true, Progress{250, 2, 300}, 0, []string{"+10", "+20"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, transport := newTestLeveling(tt.remote)
+			l.StackRewards = tt.stack
+			if tt.known {
+				if err := l.Store().SetTotal("s1", "u1", tt.stored); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var ups []LevelUp
+			l.OnLevelUp = func(u LevelUp) { ups = append(ups, u) }
+			l.OnError = func(err error) { t.Errorf("OnError(%v)", err) }
+
+			got, err := l.award("s1", "u1", "c1", tt.amount)
+			if err != nil {
+				t.Fatalf("award() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("award() = %+v, want %+v", got, tt.want)
+			}
+			if stored, _, _ := l.Store().Total("s1", "u1"); stored != tt.want.Total {
+				t.Errorf("stored total = %d, want %d", stored, tt.want.Total)
+			}
+
+			switch {
+			case tt.wantUp < 0 && len(ups) != 0:
+				t.Errorf("OnLevelUp() called with %+v, want no level up", ups)
+			case tt.wantUp >= 0 && (len(ups) != 1 || ups[0].OldLevel != tt.wantUp || ups[0].Progress != tt.want || ups[0].ChannelID != "c1"):
+				t.Errorf("OnLevelUp() called with %+v, want one level up from %d", ups, tt.wantUp)
+			}
+			if !reflect.DeepEqual(transport.changes, tt.changes) {
+				t.Errorf("role changes = %v, want %v", transport.changes, tt.changes)
+			}
+		})
+	}
+}
+
+func TestAwardConcurrent(t *testing.T) {
+	l, transport := newTestLeveling(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := l.Award("s1", "u1", 10); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stored, _, _ := l.Store().Total("s1", "u1"); stored != 500 || transport.total != 500 {
+		t.Errorf("stored total = %d, Guilded total = %d, want 500", stored, transport.total)
+	}
+	if len(l.members) != 0 {
+		t.Errorf("%d member locks left, want none", len(l.members))
+	}
+}
+
+func TestGiveRewards(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int
+		stack    bool
+		want     []guildrone.RoleID
+		changes  []string
+	}{
+		{"one level", 0, 1, true, []guildrone.RoleID{10}, []string{"+10"}},
+		{"no reward reached", 3, 5, true, nil, nil},
+		{"stacked", 1, 3, true, []guildrone.RoleID{20, 30}, []string{"+20", "+30"}},
+		{"not stacked", 1, 3, false, []guildrone.RoleID{20, 30}, []string{"+20", "+30", "-10", "-20"}},
+		{"nothing given keeps the lower rewards", 3, 4, false, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, transport := newTestLeveling(0)
+			l.StackRewards = tt.stack
+
+			got := l.giveRewards("s1", "u1", tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("giveRewards(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+			if !reflect.DeepEqual(transport.changes, tt.changes) {
+				t.Errorf("role changes = %v, want %v", transport.changes, tt.changes)
+			}
+		})
+	}
+}
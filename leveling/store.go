@@ -0,0 +1,143 @@
+package leveling
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/fileutil"
+)
+
+// Standing is the XP total of a member.
+type Standing struct {
+	UserID guildrone.UserID `json:"userId"`
+	Total  int              `json:"total"`
+}
+
+// Store keeps the XP totals of members.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Total returns the XP total of a member, or false if it is unknown.
+	Total(serverID guildrone.ServerID, userID guildrone.UserID) (int, bool, error)
+
+	// SetTotal saves the XP total of a member.
+	SetTotal(serverID guildrone.ServerID, userID guildrone.UserID, total int) error
+
+	// Top returns the n members of a server with the most XP, highest first.
+	Top(serverID guildrone.ServerID, n int) ([]Standing, error)
+}
+
+// MemoryStore is a Store keeping totals in memory.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	totals map[guildrone.ServerID]map[guildrone.UserID]int
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{totals: map[guildrone.ServerID]map[guildrone.UserID]int{}}
+}
+
+// Total implements Store.
+func (s *MemoryStore) Total(serverID guildrone.ServerID, userID guildrone.UserID) (int, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	total, ok := s.totals[serverID][userID]
+	return total, ok, nil
+}
+
+// SetTotal implements Store.
+func (s *MemoryStore) SetTotal(serverID guildrone.ServerID, userID guildrone.UserID, total int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.totals[serverID] == nil {
+		s.totals[serverID] = map[guildrone.UserID]int{}
+	}
+	s.totals[serverID][userID] = total
+	return nil
+}
+
+// Top implements Store.
+func (s *MemoryStore) Top(serverID guildrone.ServerID, n int) ([]Standing, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return top(s.totals[serverID], n), nil
+}
+
+// FileStore is a MemoryStore saved to a JSON file on every change.
+type FileStore struct {
+	MemoryStore
+	path string
+}
+
+// OpenFileStore opens the JSON file at path.
+// The file is created on the first change if it does not exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{MemoryStore: *NewMemoryStore(), path: path}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &s.totals); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetTotal implements Store.
+func (s *FileStore) SetTotal(serverID guildrone.ServerID, userID guildrone.UserID, total int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.totals[serverID] == nil {
+		s.totals[serverID] = map[guildrone.UserID]int{}
+	}
+	old, existed := s.totals[serverID][userID]
+	s.totals[serverID][userID] = total
+
+	if err := s.write(); err != nil {
+		if existed {
+			s.totals[serverID][userID] = old
+		} else {
+			delete(s.totals[serverID], userID)
+		}
+		return err
+	}
+	return nil
+}
+
+// write replaces the file with the current totals, s.mu must be locked.
+func (s *FileStore) write() error {
+	b, err := json.Marshal(s.totals)
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteAtomic(s.path, b)
+}
+
+// top returns the n highest totals, ties ordered by user ID.
+func top(totals map[guildrone.UserID]int, n int) []Standing {
+	standings := make([]Standing, 0, len(totals))
+	for id, total := range totals {
+		standings = append(standings, Standing{UserID: id, Total: total})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Total != standings[j].Total {
+			return standings[i].Total > standings[j].Total
+		}
+		return standings[i].UserID < standings[j].UserID
+	})
+	if n > 0 && len(standings) > n {
+		standings = standings[:n]
+	}
+	return standings
+}
@@ -0,0 +1,93 @@
+package leveling
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+func TestTop(t *testing.T) {
+	totals := map[guildrone.UserID]int{"ua": 120, "ub": 300, "uc": 300, "ud": 10}
+
+	tests := []struct {
+		name string
+		n    int
+		want []Standing
+	}{
+		{"ties by user ID", 3, []Standing{{"ub", 300}, {"uc", 300}, {"ua", 120}}},
+		{"one", 1, []Standing{{"ub", 300}}},
+		{"more than known", 10, []Standing{{"ub", 300}, {"uc", 300}, {"ua", 120}, {"ud", 10}}},
+		{"all", 0, []Standing{{"ub", 300}, {"uc", 300}, {"ua", 120}, {"ud", 10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := top(totals, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("top(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreTotal(t *testing.T) {
+	s := NewMemoryStore()
+	if err := s.SetTotal("s1", "ua", 50); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetTotal("s2", "ua", 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	if total, ok, err := s.Total("s1", "ua"); err != nil || !ok || total != 50 {
+		t.Errorf("Total(s1, ua) = %d, %v, %v, want 50, true", total, ok, err)
+	}
+	if _, ok, err := s.Total("s1", "ub"); err != nil || ok {
+		t.Errorf("Total(s1, ub) = %v, %v, want unknown", ok, err)
+	}
+	if top, _ := s.Top("s3", 3); len(top) != 0 {
+		t.Errorf("Top() of an unknown server = %v, want none", top)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "xp.json")
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.SetTotal("s1", "ua", 50); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.SetTotal("s1", "ua", 120); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	if total, ok, _ := reopened.Total("s1", "ua"); !ok || total != 120 {
+		t.Errorf("reopened Total() = %d, %v, want 120, true", total, ok)
+	}
+
+	// A failed write keeps the previous totals.
+	if err = os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.SetTotal("s1", "ua", 500); err == nil {
+		t.Error("SetTotal() without a directory error = nil")
+	}
+	if err = s.SetTotal("s1", "ub", 500); err == nil {
+		t.Error("SetTotal() without a directory error = nil")
+	}
+	if total, _, _ := s.Total("s1", "ua"); total != 120 {
+		t.Errorf("Total(ua) after a failed write = %d, want 120", total)
+	}
+	if _, ok, _ := s.Total("s1", "ub"); ok {
+		t.Error("Total(ub) after a failed write is known")
+	}
+}
@@ -0,0 +1,296 @@
+package guildrone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrSessionExists is returned when adding a session under a name
+// that is already used by the Manager.
+var ErrSessionExists = errors.New("session already exists")
+
+// SessionErrors maps the names of the sessions of a Manager to the
+// error they failed with.
+type SessionErrors map[string]error
+
+// Error returns all the session errors sorted by session name.
+func (e SessionErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, 0, len(names))
+	for _, name := range names {
+		msgs = append(msgs, name+": "+e[name].Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// SessionHealth is the connection health of a session of a Manager.
+type SessionHealth struct {
+	Name              string
+	User              *BotUser
+	Connected         bool
+	LastHeartbeatAck  time.Time
+	LastHeartbeatSent time.Time
+}
+
+// Manager runs several sessions, one per bot token, from one process.
+// Handlers added to the Manager are called for the events of every session,
+// the session the event comes from is passed as the first argument.
+// Every session keeps its own websocket and reconnect loop, so one token
+// failing does not affect the others.
+type Manager struct {
+	sync.RWMutex
+
+	// HTTP client shared by all the sessions
+	Client *http.Client
+
+	// Delay between connecting two sessions
+	ConnectDelay time.Duration
+
+	names    []string
+	sessions map[string]*Session
+	handlers []*managerHandler
+}
+
+// managerHandler is a handler added to every session of a Manager.
+type managerHandler struct {
+	handler  interface{}
+	removers map[*Session]func()
+}
+
+// NewManager creates a new Manager.
+func NewManager() *Manager {
+	return &Manager{
+		Client:       &http.Client{Timeout: (20 * time.Second)},
+		ConnectDelay: 5 * time.Second,
+		sessions:     map[string]*Session{},
+	}
+}
+
+// AddSession creates a session for the token and adds it to the Manager.
+// The session shares the Manager HTTP client and handlers.
+// name  : The name identifying the session in the Manager.
+// token : The bot token of the session.
+func (m *Manager) AddSession(name, token string) (*Session, error) {
+	s, err := New(token)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.sessions[name]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrSessionExists, name)
+	}
+
+	s.Client = m.Client
+	for _, h := range m.handlers {
+		h.removers[s] = s.AddHandler(h.handler)
+	}
+
+	m.names = append(m.names, name)
+	m.sessions[name] = s
+	return s, nil
+}
+
+// RemoveSession closes the session and removes it from the Manager.
+// name : The name of the session.
+func (m *Manager) RemoveSession(name string) error {
+	m.Lock()
+	s, ok := m.sessions[name]
+	if !ok {
+		m.Unlock()
+		return nil
+	}
+
+	delete(m.sessions, name)
+	for i, n := range m.names {
+		if n == name {
+			m.names = append(m.names[:i], m.names[i+1:]...)
+			break
+		}
+	}
+	for _, h := range m.handlers {
+		if remove, ok := h.removers[s]; ok {
+			remove()
+			delete(h.removers, s)
+		}
+	}
+	m.Unlock()
+
+	return s.Close()
+}
+
+// Session returns the session with the given name, or nil.
+func (m *Manager) Session(name string) *Session {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.sessions[name]
+}
+
+// Sessions returns all the sessions in the order they were added.
+func (m *Manager) Sessions() []*Session {
+	m.RLock()
+	defer m.RUnlock()
+
+	sessions := make([]*Session, 0, len(m.names))
+	for _, name := range m.names {
+		sessions = append(sessions, m.sessions[name])
+	}
+	return sessions
+}
+
+// AddHandler adds an event handler to every session, including the
+// sessions added later. See Session.AddHandler for the handler signatures.
+// The return value is a function removing the handler from every session.
+func (m *Manager) AddHandler(handler interface{}) func() {
+	m.Lock()
+	defer m.Unlock()
+
+	h := &managerHandler{
+		handler:  handler,
+		removers: map[*Session]func(){},
+	}
+	for _, s := range m.sessions {
+		h.removers[s] = s.AddHandler(handler)
+	}
+	m.handlers = append(m.handlers, h)
+
+	return func() {
+		m.Lock()
+		defer m.Unlock()
+
+		for i := range m.handlers {
+			if m.handlers[i] == h {
+				m.handlers = append(m.handlers[:i], m.handlers[i+1:]...)
+				break
+			}
+		}
+		for s, remove := range h.removers {
+			remove()
+			delete(h.removers, s)
+		}
+	}
+}
+
+// Open connects all the sessions one after the other, waiting ConnectDelay
+// between two of them. A session failing to connect does not stop the others,
+// its reconnect loop is started in the background instead.
+// Returns SessionErrors if any session failed to connect.
+func (m *Manager) Open(ctx context.Context) error {
+	m.RLock()
+	names := append([]string(nil), m.names...)
+	m.RUnlock()
+
+	errs := SessionErrors{}
+	for i, name := range names {
+		if i > 0 && m.ConnectDelay > 0 {
+			select {
+			case <-time.After(m.ConnectDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		s := m.Session(name)
+		if s == nil {
+			continue
+		}
+
+		err := s.OpenAndWait(ctx)
+		if err == nil || err == ErrWSAlreadyOpen {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		s.log(LogWarning, "session %s failed to connect, %s", name, err)
+		errs[name] = err
+		go s.reconnect()
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Close closes all the sessions.
+// Returns SessionErrors if any session failed to close.
+func (m *Manager) Close() error {
+	m.RLock()
+	sessions := make(map[string]*Session, len(m.sessions))
+	for name, s := range m.sessions {
+		sessions[name] = s
+	}
+	m.RUnlock()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := SessionErrors{}
+	for name, s := range sessions {
+		wg.Add(1)
+		go func(name string, s *Session) {
+			defer wg.Done()
+			if err := s.Close(); err != nil {
+				mu.Lock()
+				errs[name] = err
+				mu.Unlock()
+			}
+		}(name, s)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Health returns the connection health of every session,
+// in the order they were added. It does not wait for a session
+// that is connecting, which is reported as not connected.
+func (m *Manager) Health() []SessionHealth {
+	m.RLock()
+	names := append([]string(nil), m.names...)
+	sessions := make([]*Session, len(names))
+	for i, name := range names {
+		sessions[i] = m.sessions[name]
+	}
+	m.RUnlock()
+
+	health := make([]SessionHealth, 0, len(names))
+	for i, s := range sessions {
+		s.healthMu.RLock()
+		h := s.health
+		s.healthMu.RUnlock()
+
+		h.Name = names[i]
+		h.User = s.CurrentUser()
+		health = append(health, h)
+	}
+	return health
+}
+
+// Healthy returns true if every session is connected.
+func (m *Manager) Healthy() bool {
+	for _, h := range m.Health() {
+		if !h.Connected {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,35 @@
+package guildrone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManagerHealthDuringOpen(t *testing.T) {
+	m := NewManager()
+	connected, err := m.AddSession("connected", "token1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opening, err := m.AddSession("opening", "token2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	connected.updateHealth(func(h *SessionHealth) { h.Connected = true })
+
+	// open holds the Session lock until the welcome message arrives.
+	opening.Lock()
+	defer opening.Unlock()
+
+	done := make(chan []SessionHealth)
+	go func() { done <- m.Health() }()
+
+	select {
+	case health := <-done:
+		if len(health) != 2 || health[0].Name != "connected" || !health[0].Connected || health[1].Name != "opening" || health[1].Connected {
+			t.Errorf("Health() = %+v, want connected and opening, only the first connected", health)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Health() blocked on a session that is connecting")
+	}
+}
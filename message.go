@@ -13,19 +13,19 @@ const (
 
 // ChatMessage stores the data of a chat message
 type ChatMessage struct {
-	ID                 string      `json:"id"`
+	ID                 MessageID   `json:"id"`
 	Type               MessageType `json:"type"`
-	ServerID           string      `json:"serverId"`
-	ChannelID          string      `json:"channelId"`
+	ServerID           ServerID    `json:"serverId"`
+	ChannelID          ChannelID   `json:"channelId"`
 	Content            string      `json:"content"`
 	Embeds             []ChatEmbed `json:"embeds" validate:"omitempty,min=1,max=10,dive"`
-	ReplyMessageIds    []string    `json:"replyMessageIds" validate:"omitempty,min=1,max=5"`
+	ReplyMessageIds    []MessageID `json:"replyMessageIds" validate:"omitempty,min=1,max=5"`
 	IsPrivate          bool        `json:"isPrivate"`
 	IsSilent           bool        `json:"isSilent"`
 	Mentions           *Mentions   `json:"mentions,omitempty" validate:"omitempty,dive"`
 	CreatedAt          time.Time   `json:"createdAt"`
-	CreatedBy          string      `json:"createdBy"`
-	CreatedByWebhookId string      `json:"createdByWebhookId"`
+	CreatedBy          UserID      `json:"createdBy"`
+	CreatedByWebhookId WebhookID   `json:"createdByWebhookId"`
 	UpdatedAt          *time.Time  `json:"updatedAt,omitempty"`
 }
 
@@ -79,22 +79,22 @@ type ChatEmbedField struct {
 }
 
 type MentionUser struct {
-	ID string `json:"id"`
+	ID UserID `json:"id"`
 }
 
 type MentionChannel struct {
-	ID string `json:"id"`
+	ID ChannelID `json:"id"`
 }
 
 type MentionRole struct {
-	ID string `json:"id"`
+	ID RoleID `json:"id"`
 }
 
 // MessageCreate is a request body for creating a message
 type MessageCreate struct {
 	IsPrivate       bool        `json:"isPrivate,omitempty"`
 	IsSilent        bool        `json:"isSilent,omitempty"`
-	ReplyMessageIds []string    `json:"replyMessageIds,omitempty" validate:"omitempty,min=1,max=5"`
+	ReplyMessageIds []MessageID `json:"replyMessageIds,omitempty" validate:"omitempty,min=1,max=5"`
 	Content         string      `json:"content,omitempty" validate:"max=4000"`
 	Embeds          []ChatEmbed `json:"embeds,omitempty" validate:"omitempty,dive"`
 }
@@ -106,6 +106,21 @@ func (m *MessageCreate) Validate() error {
 	return validate.Struct(m)
 }
 
+// WebhookExecute is a request body for posting a message through a webhook
+type WebhookExecute struct {
+	Content   string      `json:"content,omitempty" validate:"max=4000"`
+	Username  string      `json:"username,omitempty" validate:"max=128"`
+	AvatarURL string      `json:"avatar_url,omitempty" validate:"omitempty,url,max=1024"`
+	Embeds    []ChatEmbed `json:"embeds,omitempty" validate:"omitempty,max=10,dive"`
+}
+
+// Validate validates the WebhookExecute request body
+// Returns nil if no errors were found
+func (m *WebhookExecute) Validate() error {
+	validate := validator.New()
+	return validate.Struct(m)
+}
+
 // MessagesRequest is a request body for getting messages
 type MessagesRequest struct {
 	Before         *time.Time `json:"before,omitempty"`
@@ -0,0 +1,340 @@
+package guildrone
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Permission is the name of a Guilded permission.
+// See: https://www.guilded.gg/docs/api/Permissions
+type Permission string
+
+// General permissions
+const (
+	PermissionCanUpdateServer        Permission = "CanUpdateServer"
+	PermissionCanManageRoles         Permission = "CanManageRoles"
+	PermissionCanInviteMembers       Permission = "CanInviteMembers"
+	PermissionCanKickMembers         Permission = "CanKickMembers"
+	PermissionCanManageGroups        Permission = "CanManageGroups"
+	PermissionCanManageChannels      Permission = "CanManageChannels"
+	PermissionCanManageWebhooks      Permission = "CanManageWebhooks"
+	PermissionCanMentionEveryone     Permission = "CanMentionEveryone"
+	PermissionCanModerateChannels    Permission = "CanModerateChannels"
+	PermissionCanBypassSlowMode      Permission = "CanBypassSlowMode"
+	PermissionCanReadApplications    Permission = "CanReadApplications"
+	PermissionCanApproveApplications Permission = "CanApproveApplications"
+	PermissionCanEditApplicationForm Permission = "CanEditApplicationForm"
+	PermissionCanIndicateLfmInterest Permission = "CanIndicateLfmInterest"
+	PermissionCanModifyLfmStatus     Permission = "CanModifyLfmStatus"
+	PermissionCanManageEmotes        Permission = "CanManageEmotes"
+	PermissionCanChangeNickname      Permission = "CanChangeNickname"
+	PermissionCanManageNicknames     Permission = "CanManageNicknames"
+	PermissionCanManageServerXp      Permission = "CanManageServerXp"
+	PermissionCanManageBotFlows      Permission = "CanManageBotFlows"
+)
+
+// Content permissions
+const (
+	PermissionCanReadAnnouncements     Permission = "CanReadAnnouncements"
+	PermissionCanCreateAnnouncements   Permission = "CanCreateAnnouncements"
+	PermissionCanManageAnnouncements   Permission = "CanManageAnnouncements"
+	PermissionCanReadChats             Permission = "CanReadChats"
+	PermissionCanCreateChats           Permission = "CanCreateChats"
+	PermissionCanUploadChatMedia       Permission = "CanUploadChatMedia"
+	PermissionCanCreateThreads         Permission = "CanCreateThreads"
+	PermissionCanCreateThreadMessages  Permission = "CanCreateThreadMessages"
+	PermissionCanCreatePrivateMessages Permission = "CanCreatePrivateMessages"
+	PermissionCanReadPrivateMessages   Permission = "CanReadPrivateMessages"
+	PermissionCanManageChats           Permission = "CanManageChats"
+	PermissionCanManageThreads         Permission = "CanManageThreads"
+	PermissionCanReadEvents            Permission = "CanReadEvents"
+	PermissionCanCreateEvents          Permission = "CanCreateEvents"
+	PermissionCanEditEvents            Permission = "CanEditEvents"
+	PermissionCanDeleteEvents          Permission = "CanDeleteEvents"
+	PermissionCanEditEventRsvps        Permission = "CanEditEventRsvps"
+	PermissionCanReadForums            Permission = "CanReadForums"
+	PermissionCanCreateTopics          Permission = "CanCreateTopics"
+	PermissionCanCreateTopicReplies    Permission = "CanCreateTopicReplies"
+	PermissionCanDeleteTopics          Permission = "CanDeleteTopics"
+	PermissionCanStickyTopics          Permission = "CanStickyTopics"
+	PermissionCanLockTopics            Permission = "CanLockTopics"
+	PermissionCanReadDocs              Permission = "CanReadDocs"
+	PermissionCanCreateDocs            Permission = "CanCreateDocs"
+	PermissionCanEditDocs              Permission = "CanEditDocs"
+	PermissionCanDeleteDocs            Permission = "CanDeleteDocs"
+	PermissionCanReadMedia             Permission = "CanReadMedia"
+	PermissionCanAddMedia              Permission = "CanAddMedia"
+	PermissionCanEditMedia             Permission = "CanEditMedia"
+	PermissionCanDeleteMedia           Permission = "CanDeleteMedia"
+	PermissionCanReadListItems         Permission = "CanReadListItems"
+	PermissionCanCreateListItems       Permission = "CanCreateListItems"
+	PermissionCanUpdateListItems       Permission = "CanUpdateListItems"
+	PermissionCanRemoveListItems       Permission = "CanRemoveListItems"
+	PermissionCanCompleteListItems     Permission = "CanCompleteListItems"
+	PermissionCanReorderListItems      Permission = "CanReorderListItems"
+	PermissionCanViewPollResults       Permission = "CanViewPollResults"
+	PermissionCanCreatePolls           Permission = "CanCreatePolls"
+)
+
+// Voice and stream permissions
+const (
+	PermissionCanListenVoice       Permission = "CanListenVoice"
+	PermissionCanAddVoice          Permission = "CanAddVoice"
+	PermissionCanManageVoiceGroups Permission = "CanManageVoiceGroups"
+	PermissionCanAssignVoiceGroup  Permission = "CanAssignVoiceGroup"
+	PermissionCanBroadcastVoice    Permission = "CanBroadcastVoice"
+	PermissionCanDirectVoice       Permission = "CanDirectVoice"
+	PermissionCanPrioritizeVoice   Permission = "CanPrioritizeVoice"
+	PermissionCanUseVoiceActivity  Permission = "CanUseVoiceActivity"
+	PermissionCanMuteMembers       Permission = "CanMuteMembers"
+	PermissionCanDeafenMembers     Permission = "CanDeafenMembers"
+	PermissionCanSendVoiceMessages Permission = "CanSendVoiceMessages"
+	PermissionCanCreateStreams     Permission = "CanCreateStreams"
+	PermissionCanJoinStreamVoice   Permission = "CanJoinStreamVoice"
+	PermissionCanAddStreamVoice    Permission = "CanAddStreamVoice"
+)
+
+// Scheduling and tournament permissions
+const (
+	PermissionCanCreateScrims           Permission = "CanCreateScrims"
+	PermissionCanManageTournaments      Permission = "CanManageTournaments"
+	PermissionCanRegisterForTournaments Permission = "CanRegisterForTournaments"
+)
+
+// AllPermissions lists every known permission, server owners have all of them.
+var AllPermissions = []Permission{
+	PermissionCanUpdateServer, PermissionCanManageRoles, PermissionCanInviteMembers, PermissionCanKickMembers,
+	PermissionCanManageGroups, PermissionCanManageChannels, PermissionCanManageWebhooks, PermissionCanMentionEveryone,
+	PermissionCanModerateChannels, PermissionCanBypassSlowMode, PermissionCanReadApplications, PermissionCanApproveApplications,
+	PermissionCanEditApplicationForm, PermissionCanIndicateLfmInterest, PermissionCanModifyLfmStatus, PermissionCanManageEmotes,
+	PermissionCanChangeNickname, PermissionCanManageNicknames, PermissionCanManageServerXp, PermissionCanManageBotFlows,
+	PermissionCanReadAnnouncements, PermissionCanCreateAnnouncements, PermissionCanManageAnnouncements, PermissionCanReadChats,
+	PermissionCanCreateChats, PermissionCanUploadChatMedia, PermissionCanCreateThreads, PermissionCanCreateThreadMessages,
+	PermissionCanCreatePrivateMessages, PermissionCanReadPrivateMessages, PermissionCanManageChats, PermissionCanManageThreads,
+	PermissionCanReadEvents, PermissionCanCreateEvents, PermissionCanEditEvents, PermissionCanDeleteEvents,
+	PermissionCanEditEventRsvps, PermissionCanReadForums, PermissionCanCreateTopics, PermissionCanCreateTopicReplies,
+	PermissionCanDeleteTopics, PermissionCanStickyTopics, PermissionCanLockTopics, PermissionCanReadDocs,
+	PermissionCanCreateDocs, PermissionCanEditDocs, PermissionCanDeleteDocs, PermissionCanReadMedia,
+	PermissionCanAddMedia, PermissionCanEditMedia, PermissionCanDeleteMedia, PermissionCanReadListItems,
+	PermissionCanCreateListItems, PermissionCanUpdateListItems, PermissionCanRemoveListItems, PermissionCanCompleteListItems,
+	PermissionCanReorderListItems, PermissionCanViewPollResults, PermissionCanCreatePolls, PermissionCanListenVoice,
+	PermissionCanAddVoice, PermissionCanManageVoiceGroups, PermissionCanAssignVoiceGroup, PermissionCanBroadcastVoice,
+	PermissionCanDirectVoice, PermissionCanPrioritizeVoice, PermissionCanUseVoiceActivity, PermissionCanMuteMembers,
+	PermissionCanDeafenMembers, PermissionCanSendVoiceMessages, PermissionCanCreateStreams, PermissionCanJoinStreamVoice,
+	PermissionCanAddStreamVoice, PermissionCanCreateScrims, PermissionCanManageTournaments, PermissionCanRegisterForTournaments,
+}
+
+// Permissions is a set of permissions.
+// It is encoded in JSON as an array of permission names.
+type Permissions map[Permission]bool
+
+// NewPermissions returns a set holding the given permissions.
+func NewPermissions(perms ...Permission) Permissions {
+	p := make(Permissions, len(perms))
+	p.Add(perms...)
+	return p
+}
+
+// Has returns true if the set holds all the given permissions.
+func (p Permissions) Has(perms ...Permission) bool {
+	for _, perm := range perms {
+		if !p[perm] {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds permissions to the set.
+func (p Permissions) Add(perms ...Permission) {
+	for _, perm := range perms {
+		p[perm] = true
+	}
+}
+
+// Remove removes permissions from the set.
+func (p Permissions) Remove(perms ...Permission) {
+	for _, perm := range perms {
+		delete(p, perm)
+	}
+}
+
+// Apply adds the allowed and removes the denied permissions of the overrides.
+func (p Permissions) Apply(o PermissionOverrides) {
+	for perm, allow := range o {
+		if allow {
+			p[perm] = true
+		} else {
+			delete(p, perm)
+		}
+	}
+}
+
+// List returns the permissions of the set sorted by name.
+func (p Permissions) List() []Permission {
+	list := make([]Permission, 0, len(p))
+	for perm, ok := range p {
+		if ok {
+			list = append(list, perm)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i] < list[j]
+	})
+	return list
+}
+
+// MarshalJSON encodes the set as an array of permission names.
+func (p Permissions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.List())
+}
+
+// UnmarshalJSON decodes the set from an array of permission names.
+func (p *Permissions) UnmarshalJSON(b []byte) error {
+	var list []Permission
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+
+	*p = NewPermissions(list...)
+	return nil
+}
+
+// PermissionOverrides are the permissions a channel or category override
+// allows (true) or denies (false). Missing permissions are inherited.
+type PermissionOverrides map[Permission]bool
+
+// ChannelRolePermission is a role permission override of a channel.
+type ChannelRolePermission struct {
+	Permissions PermissionOverrides `json:"permissions"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+	RoleID      RoleID              `json:"roleId"`
+	ChannelID   ChannelID           `json:"channelId"`
+}
+
+// ChannelUserPermission is a user permission override of a channel.
+type ChannelUserPermission struct {
+	Permissions PermissionOverrides `json:"permissions"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+	UserID      UserID              `json:"userId"`
+	ChannelID   ChannelID           `json:"channelId"`
+}
+
+// CategoryRolePermission is a role permission override of a channel category.
+type CategoryRolePermission struct {
+	Permissions PermissionOverrides `json:"permissions"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+	RoleID      RoleID              `json:"roleId"`
+	CategoryID  CategoryID          `json:"categoryId"`
+}
+
+// CategoryUserPermission is a user permission override of a channel category.
+type CategoryUserPermission struct {
+	Permissions PermissionOverrides `json:"permissions"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
+	UserID      UserID              `json:"userId"`
+	CategoryID  CategoryID          `json:"categoryId"`
+}
+
+// PermissionOverridesUpdate is the request body for creating or updating a permission override
+type PermissionOverridesUpdate struct {
+	Permissions PermissionOverrides `json:"permissions"`
+}
+
+// ChannelPermissionOverrides holds every override applying to a member in a channel.
+// Category overrides are only set for channels in a category.
+type ChannelPermissionOverrides struct {
+	CategoryRoles []*CategoryRolePermission
+	CategoryUser  *CategoryUserPermission
+	Roles         []*ChannelRolePermission
+	User          *ChannelUserPermission
+}
+
+// MemberPermissions returns the effective permissions of a member.
+// The permissions of the member roles are combined first, then the overrides
+// are applied from the least to the most specific: category roles, category
+// user, channel roles and channel user. Role overrides of higher positioned
+// roles win. Server owners have every permission.
+// member    : The member to compute the permissions of.
+// roles     : The roles of the server.
+// overrides : The overrides of the channel, nil for server wide permissions.
+func MemberPermissions(member *ServerMember, roles []*Role, overrides *ChannelPermissionOverrides) Permissions {
+	if member.IsOwner {
+		return NewPermissions(AllPermissions...)
+	}
+
+	// The base role applies to every member.
+	memberRoles := member.Roles(roles)
+	for _, r := range roles {
+		if r.IsBase && !member.hasRole(r.ID) {
+			memberRoles = append(memberRoles, r)
+		}
+	}
+
+	p := Permissions{}
+	positions := make(map[RoleID]int, len(memberRoles))
+	for _, r := range memberRoles {
+		for perm, ok := range r.Permissions {
+			if ok {
+				p[perm] = true
+			}
+		}
+		positions[r.ID] = r.Position
+	}
+
+	if overrides == nil {
+		return p
+	}
+
+	// Apply role overrides from the lowest to the highest role position.
+	var categoryRoles []*CategoryRolePermission
+	for _, o := range overrides.CategoryRoles {
+		if _, ok := positions[o.RoleID]; ok {
+			categoryRoles = append(categoryRoles, o)
+		}
+	}
+	sort.SliceStable(categoryRoles, func(i, j int) bool {
+		return positions[categoryRoles[i].RoleID] < positions[categoryRoles[j].RoleID]
+	})
+	for _, o := range categoryRoles {
+		p.Apply(o.Permissions)
+	}
+
+	if overrides.CategoryUser != nil && overrides.CategoryUser.UserID == member.User.ID {
+		p.Apply(overrides.CategoryUser.Permissions)
+	}
+
+	var channelRoles []*ChannelRolePermission
+	for _, o := range overrides.Roles {
+		if _, ok := positions[o.RoleID]; ok {
+			channelRoles = append(channelRoles, o)
+		}
+	}
+	sort.SliceStable(channelRoles, func(i, j int) bool {
+		return positions[channelRoles[i].RoleID] < positions[channelRoles[j].RoleID]
+	})
+	for _, o := range channelRoles {
+		p.Apply(o.Permissions)
+	}
+
+	if overrides.User != nil && overrides.User.UserID == member.User.ID {
+		p.Apply(overrides.User.Permissions)
+	}
+
+	return p
+}
+
+// hasRole returns true if the member has the role.
+func (m *ServerMember) hasRole(roleID RoleID) bool {
+	for _, id := range m.RoleIds {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package guildrone
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPermissionsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		perms Permissions
+		json  string
+	}{
+		{"empty", Permissions{}, `[]`},
+		{"sorted", NewPermissions(PermissionCanManageRoles, PermissionCanKickMembers), `["CanKickMembers","CanManageRoles"]`},
+		{"false entries dropped", Permissions{PermissionCanKickMembers: true, PermissionCanManageRoles: false}, `["CanKickMembers"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.perms)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(b) != tt.json {
+				t.Errorf("Marshal() = %s, want %s", b, tt.json)
+			}
+
+			var got Permissions
+			if err = json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.List(), tt.perms.List()) {
+				t.Errorf("Unmarshal() = %v, want %v", got.List(), tt.perms.List())
+			}
+		})
+	}
+}
+
+func TestMemberPermissions(t *testing.T) {
+	const (
+		kick   = PermissionCanKickMembers
+		roles  = PermissionCanManageRoles
+		groups = PermissionCanManageGroups
+	)
+
+	serverRoles := []*Role{
+		{ID: 1, Position: 0, IsBase: true, Permissions: NewPermissions(groups)},
+		{ID: 2, Position: 1, Permissions: NewPermissions(kick)},
+		{ID: 3, Position: 2, Permissions: NewPermissions(roles)},
+		{ID: 4, Position: 3, Permissions: NewPermissions(PermissionCanUpdateServer)},
+	}
+	member := &ServerMember{User: User{ID: "u1"}, RoleIds: []RoleID{2, 3}}
+
+	tests := []struct {
+		name      string
+		member    *ServerMember
+		overrides *ChannelPermissionOverrides
+		want      []Permission
+	}{
+		{
+			name:   "roles and base role",
+			member: member,
+			want:   []Permission{kick, groups, roles},
+		},
+		{
+			name:   "owner",
+			member: &ServerMember{User: User{ID: "u1"}, IsOwner: true},
+			want:   AllPermissions,
+		},
+		{
+			name:   "higher role override wins",
+			member: member,
+			overrides: &ChannelPermissionOverrides{Roles: []*ChannelRolePermission{
+				{RoleID: 3, Permissions: PermissionOverrides{kick: true}},
+				{RoleID: 2, Permissions: PermissionOverrides{kick: false}},
+			}},
+			want: []Permission{kick, groups, roles},
+		},
+		{
+			name:   "override of a role not held ignored",
+			member: member,
+			overrides: &ChannelPermissionOverrides{Roles: []*ChannelRolePermission{
+				{RoleID: 4, Permissions: PermissionOverrides{kick: false}},
+			}},
+			want: []Permission{kick, groups, roles},
+		},
+		{
+			name:   "channel role over category user",
+			member: member,
+			overrides: &ChannelPermissionOverrides{
+				CategoryUser: &CategoryUserPermission{UserID: "u1", Permissions: PermissionOverrides{kick: false}},
+				Roles:        []*ChannelRolePermission{{RoleID: 2, Permissions: PermissionOverrides{kick: true}}},
+			},
+			want: []Permission{kick, groups, roles},
+		},
+		{
+			name:   "category user over category role",
+			member: member,
+			overrides: &ChannelPermissionOverrides{
+				CategoryRoles: []*CategoryRolePermission{{RoleID: 3, Permissions: PermissionOverrides{kick: false}}},
+				CategoryUser:  &CategoryUserPermission{UserID: "u1", Permissions: PermissionOverrides{kick: true}},
+			},
+			want: []Permission{kick, groups, roles},
+		},
+		{
+			name:   "channel user last",
+			member: member,
+			overrides: &ChannelPermissionOverrides{
+				Roles: []*ChannelRolePermission{{RoleID: 3, Permissions: PermissionOverrides{PermissionCanUpdateServer: true}}},
+				User:  &ChannelUserPermission{UserID: "u1", Permissions: PermissionOverrides{PermissionCanUpdateServer: false, groups: false}},
+			},
+			want: []Permission{kick, roles},
+		},
+		{
+			name:   "other user override ignored",
+			member: member,
+			overrides: &ChannelPermissionOverrides{
+				User: &ChannelUserPermission{UserID: "u2", Permissions: PermissionOverrides{kick: false}},
+			},
+			want: []Permission{kick, groups, roles},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MemberPermissions(tt.member, serverRoles, tt.overrides).List()
+			want := NewPermissions(tt.want...).List()
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("MemberPermissions() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package reactionroles gives members roles when they react to messages.
+//
+// A Manager binds emotes on messages to roles. Reacting with a bound emote
+// gives the role, removing the reaction takes it back. Bindings sharing an
+// exclusive group give at most one of their roles at a time. Bindings are
+// persisted through a Store:
+//
+//	store, err := reactionroles.OpenFileStore("reactionroles.json")
+//	if err != nil {
+//		return err
+//	}
+//	m, err := reactionroles.New(s, store)
+//	if err != nil {
+//		return err
+//	}
+//	defer m.Start()()
+//
+//	err = m.Bind(reactionroles.Binding{
+//		ServerID:  serverID,
+//		ChannelID: channelID,
+//		MessageID: messageID,
+//		EmoteID:   90002547,
+//		RoleID:    1234,
+//		Group:     "colors",
+//	})
+package reactionroles
+
+import (
+	"sync"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// Binding binds an emote on a message to a role.
+type Binding struct {
+	ServerID  guildrone.ServerID  `json:"serverId"`
+	ChannelID guildrone.ChannelID `json:"channelId"`
+	MessageID guildrone.MessageID `json:"messageId"`
+	EmoteID   guildrone.EmoteID   `json:"emoteId"`
+	RoleID    guildrone.RoleID    `json:"roleId"`
+
+	// Exclusive group of the binding in the server, none if empty.
+	// Members hold at most one role of a group: getting one removes the others.
+	Group string `json:"group,omitempty"`
+}
+
+type bindingKey struct {
+	messageID guildrone.MessageID
+	emoteID   guildrone.EmoteID
+}
+
+func (b *Binding) key() bindingKey {
+	return bindingKey{b.MessageID, b.EmoteID}
+}
+
+// Manager handles the reactions to bound messages.
+type Manager struct {
+	sync.RWMutex
+
+	session  *guildrone.Session
+	store    Store
+	bindings map[bindingKey]Binding
+
+	// Called with the errors of role updates, ignored if nil.
+	OnError func(err error)
+}
+
+// New creates a Manager with the bindings of the store.
+func New(s *guildrone.Session, store Store) (*Manager, error) {
+	bindings, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{
+		session:  s,
+		store:    store,
+		bindings: make(map[bindingKey]Binding, len(bindings)),
+	}
+	for _, b := range bindings {
+		m.bindings[b.key()] = b
+	}
+	return m, nil
+}
+
+// Start adds the event handlers of the manager to the session.
+// It returns a function removing them.
+func (m *Manager) Start() func() {
+	removers := []func(){
+		m.session.AddHandler(m.onReactionCreated),
+		m.session.AddHandler(m.onReactionDeleted),
+	}
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+	}
+}
+
+// Bind saves a binding, replacing the one of the same emote on the message,
+// and reacts to the message with the emote so members can click it.
+func (m *Manager) Bind(b Binding) error {
+	m.Lock()
+	if err := m.store.Save(b); err != nil {
+		m.Unlock()
+		return err
+	}
+	m.bindings[b.key()] = b
+	m.Unlock()
+
+	return m.session.ChannelMessageReactionAdd(b.ChannelID, b.MessageID, b.EmoteID)
+}
+
+// Unbind deletes the binding of an emote on a message.
+// Roles already given are kept.
+// messageID : The ID of a Message.
+// emoteID   : The ID of an Emote.
+func (m *Manager) Unbind(messageID guildrone.MessageID, emoteID guildrone.EmoteID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	key := bindingKey{messageID, emoteID}
+	if _, ok := m.bindings[key]; !ok {
+		return nil
+	}
+	if err := m.store.Delete(messageID, emoteID); err != nil {
+		return err
+	}
+	delete(m.bindings, key)
+	return nil
+}
+
+// Bindings returns the bindings of a message.
+// messageID : The ID of a Message.
+func (m *Manager) Bindings(messageID guildrone.MessageID) []Binding {
+	m.RLock()
+	defer m.RUnlock()
+
+	var bindings []Binding
+	for _, b := range m.bindings {
+		if b.MessageID == messageID {
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings
+}
+
+func (m *Manager) onReactionCreated(s *guildrone.Session, r *guildrone.ChannelMessageReactionCreated) {
+	b, ok := m.binding(&r.Reaction)
+	if !ok {
+		return
+	}
+	userID := r.Reaction.CreatedBy
+
+	for _, other := range m.groupRoles(r.ServerID, b) {
+		if m.hasRole(r.ServerID, userID, other) {
+			m.report(s.ServerMemberRoleRemove(r.ServerID, userID, other))
+		}
+	}
+	m.report(s.ServerMemberRoleAdd(r.ServerID, userID, b.RoleID))
+}
+
+func (m *Manager) onReactionDeleted(s *guildrone.Session, r *guildrone.ChannelMessageReactionDeleted) {
+	b, ok := m.binding(&r.Reaction)
+	if !ok {
+		return
+	}
+	m.report(s.ServerMemberRoleRemove(r.ServerID, r.Reaction.CreatedBy, b.RoleID))
+}
+
+// binding returns the binding of a reaction,
+// ignoring the reactions of the bot itself.
+func (m *Manager) binding(r *guildrone.Reaction) (Binding, bool) {
+	if u := m.session.CurrentUser(); u != nil && r.CreatedBy == u.ID {
+		return Binding{}, false
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	b, ok := m.bindings[bindingKey{r.MessageID, r.Emote.ID}]
+	return b, ok
+}
+
+// groupRoles returns the other roles of the exclusive group of b.
+func (m *Manager) groupRoles(serverID guildrone.ServerID, b Binding) []guildrone.RoleID {
+	if b.Group == "" {
+		return nil
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	seen := map[guildrone.RoleID]bool{b.RoleID: true}
+	var roles []guildrone.RoleID
+	for _, other := range m.bindings {
+		if other.ServerID == serverID && other.Group == b.Group && !seen[other.RoleID] {
+			seen[other.RoleID] = true
+			roles = append(roles, other.RoleID)
+		}
+	}
+	return roles
+}
+
+// hasRole returns true if the member may have the role.
+// It is only false when the State knows the member does not have it.
+func (m *Manager) hasRole(serverID guildrone.ServerID, userID guildrone.UserID, roleID guildrone.RoleID) bool {
+	s := m.session
+	if !s.StateEnabled || s.State == nil {
+		return true
+	}
+	member, err := s.State.Member(serverID, userID)
+	if err != nil {
+		return true
+	}
+	for _, id := range member.RoleIds {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) report(err error) {
+	if err != nil && m.OnError != nil {
+		m.OnError(err)
+	}
+}
@@ -0,0 +1,125 @@
+package reactionroles
+
+import (
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+// roleTransport records the role changes requested, "+10" for giving
+// role 10 and "-10" for taking it back.
+type roleTransport struct {
+	changes []string
+}
+
+func (r *roleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/roles/") {
+		op := "+"
+		if req.Method == "DELETE" {
+			op = "-"
+		}
+		r.changes = append(r.changes, op+path.Base(req.URL.Path))
+	}
+	return &http.Response{
+		Status:     "204 No Content",
+		StatusCode: http.StatusNoContent,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestManagerReactions(t *testing.T) {
+	bindings := []Binding{
+		{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 1, RoleID: 10, Group: "colors"},
+		{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 2, RoleID: 20, Group: "colors"},
+		{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 3, RoleID: 30},
+		{ServerID: "s1", ChannelID: "c1", MessageID: "m2", EmoteID: 1, RoleID: 20, Group: "colors"},
+		{ServerID: "s2", ChannelID: "c2", MessageID: "m3", EmoteID: 1, RoleID: 40, Group: "colors"},
+	}
+
+	tests := []struct {
+		name    string
+		emoteID guildrone.EmoteID
+		deleted bool
+		// Roles of the member in the State, no State if nil.
+		roles []guildrone.RoleID
+		want  []string
+	}{
+		{"ungrouped", 3, false, nil, []string{"+30"}},
+		{"exclusive group", 1, false, nil, []string{"-20", "+10"}},
+		{"exclusive group with state", 1, false, []guildrone.RoleID{20, 30}, []string{"-20", "+10"}},
+		{"other roles not held", 1, false, []guildrone.RoleID{30}, []string{"+10"}},
+		{"unbound emote", 4, false, nil, nil},
+		{"deleted", 3, true, nil, []string{"-30"}},
+		{"deleted in group", 1, true, nil, []string{"-10"}},
+		{"deleted unbound", 4, true, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			for _, b := range bindings {
+				if err := store.Save(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			transport := &roleTransport{}
+			s := &guildrone.Session{Client: &http.Client{Transport: transport}}
+			if tt.roles != nil {
+				s.StateEnabled = true
+				s.State = guildrone.NewState()
+				s.State.MemberAdd("s1", &guildrone.ServerMember{User: guildrone.User{ID: "u1"}, RoleIds: tt.roles})
+			}
+			m, err := New(s, store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.OnError = func(err error) { t.Errorf("OnError(%v)", err) }
+
+			reaction := guildrone.Reaction{ChannelID: "c1", MessageID: "m1", CreatedBy: "u1", Emote: guildrone.Emote{ID: tt.emoteID}}
+			if tt.deleted {
+				m.onReactionDeleted(s, &guildrone.ChannelMessageReactionDeleted{ServerID: "s1", Reaction: reaction})
+			} else {
+				m.onReactionCreated(s, &guildrone.ChannelMessageReactionCreated{ServerID: "s1", Reaction: reaction})
+			}
+
+			if !reflect.DeepEqual(transport.changes, tt.want) {
+				t.Errorf("role changes = %v, want %v", transport.changes, tt.want)
+			}
+		})
+	}
+}
+
+func TestManagerBind(t *testing.T) {
+	store := NewMemoryStore()
+	s := &guildrone.Session{Client: &http.Client{Transport: &roleTransport{}}}
+	m, err := New(s, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := Binding{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 1, RoleID: 10}
+	if err = m.Bind(b); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	if got := m.Bindings("m1"); !reflect.DeepEqual(got, []Binding{b}) {
+		t.Errorf("Bindings() = %v, want %v", got, []Binding{b})
+	}
+
+	if err = m.Unbind("m1", 1); err != nil {
+		t.Fatalf("Unbind() error = %v", err)
+	}
+	if got := m.Bindings("m1"); len(got) != 0 {
+		t.Errorf("Bindings() after Unbind() = %v, want none", got)
+	}
+	if saved, _ := store.Load(); len(saved) != 0 {
+		t.Errorf("store holds %v after Unbind(), want none", saved)
+	}
+}
@@ -0,0 +1,160 @@
+package reactionroles
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/fileutil"
+)
+
+// Store persists the bindings of a Manager.
+// Calls are serialized by the Manager.
+type Store interface {
+	// Load returns every saved binding.
+	Load() ([]Binding, error)
+
+	// Save saves a binding, replacing the one of the same emote on the message.
+	Save(b Binding) error
+
+	// Delete deletes the binding of an emote on a message.
+	Delete(messageID guildrone.MessageID, emoteID guildrone.EmoteID) error
+}
+
+// MemoryStore is a Store keeping bindings in memory.
+type MemoryStore struct {
+	mu       sync.Mutex
+	bindings map[bindingKey]Binding
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{bindings: map[bindingKey]Binding{}}
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load() ([]Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedBindings(s.bindings), nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(b Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bindings[b.key()] = b
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(messageID guildrone.MessageID, emoteID guildrone.EmoteID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.bindings, bindingKey{messageID, emoteID})
+	return nil
+}
+
+// FileStore is a Store keeping bindings in a JSON file.
+// The file is rewritten on every change.
+type FileStore struct {
+	mu       sync.Mutex
+	path     string
+	bindings map[bindingKey]Binding
+}
+
+// OpenFileStore opens the JSON file at path.
+// The file is created on the first change if it does not exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, bindings: map[bindingKey]Binding{}}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []Binding
+	if err = json.Unmarshal(b, &bindings); err != nil {
+		return nil, err
+	}
+	for _, b := range bindings {
+		s.bindings[b.key()] = b
+	}
+	return s, nil
+}
+
+// Load implements Store.
+func (s *FileStore) Load() ([]Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedBindings(s.bindings), nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(b Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, existed := s.bindings[b.key()]
+	s.bindings[b.key()] = b
+	if err := s.write(); err != nil {
+		if existed {
+			s.bindings[b.key()] = old
+		} else {
+			delete(s.bindings, b.key())
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(messageID guildrone.MessageID, emoteID guildrone.EmoteID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := bindingKey{messageID, emoteID}
+	old, ok := s.bindings[key]
+	if !ok {
+		return nil
+	}
+	delete(s.bindings, key)
+	if err := s.write(); err != nil {
+		s.bindings[key] = old
+		return err
+	}
+	return nil
+}
+
+// write replaces the file with the current bindings, s.mu must be locked.
+func (s *FileStore) write() error {
+	b, err := json.MarshalIndent(sortedBindings(s.bindings), "", "\t")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteAtomic(s.path, b)
+}
+
+// sortedBindings returns the bindings ordered by message and emote.
+func sortedBindings(m map[bindingKey]Binding) []Binding {
+	bindings := make([]Binding, 0, len(m))
+	for _, b := range m {
+		bindings = append(bindings, b)
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].MessageID != bindings[j].MessageID {
+			return bindings[i].MessageID < bindings[j].MessageID
+		}
+		return bindings[i].EmoteID < bindings[j].EmoteID
+	})
+	return bindings
+}
@@ -0,0 +1,83 @@
+package reactionroles
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSortedBindings(t *testing.T) {
+	a := Binding{MessageID: "m1", EmoteID: 1, RoleID: 10}
+	b := Binding{MessageID: "m1", EmoteID: 2, RoleID: 20}
+	c := Binding{MessageID: "m2", EmoteID: 1, RoleID: 30}
+
+	tests := []struct {
+		name     string
+		bindings []Binding
+		want     []Binding
+	}{
+		{"empty", nil, []Binding{}},
+		{"by message", []Binding{c, a}, []Binding{a, c}},
+		{"by emote", []Binding{b, a}, []Binding{a, b}},
+		{"both", []Binding{c, b, a}, []Binding{a, b, c}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := map[bindingKey]Binding{}
+			for _, b := range tt.bindings {
+				m[b.key()] = b
+			}
+			if got := sortedBindings(m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortedBindings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bindings.json")
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	colors := Binding{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 1, RoleID: 10, Group: "colors"}
+	replaced := Binding{ServerID: "s1", ChannelID: "c1", MessageID: "m1", EmoteID: 1, RoleID: 20, Group: "colors"}
+	deleted := Binding{ServerID: "s1", ChannelID: "c1", MessageID: "m2", EmoteID: 1, RoleID: 30}
+	for _, b := range []Binding{colors, replaced, deleted} {
+		if err = s.Save(b); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if err = s.Delete(deleted.MessageID, deleted.EmoteID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	// The bindings survive reopening, the second save of the emote
+	// replaced the first.
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	want := []Binding{replaced}
+	if got, _ := reopened.Load(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reopened Load() = %v, want %v", got, want)
+	}
+
+	// A failed write leaves the bindings unchanged.
+	if err = os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Save(deleted); err == nil {
+		t.Error("Save() without a directory error = nil")
+	}
+	if err = s.Delete(replaced.MessageID, replaced.EmoteID); err == nil {
+		t.Error("Delete() without a directory error = nil")
+	}
+	if got, _ := s.Load(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() after failed writes = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package guildrone
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ErrReconnectCancelled is reported by the ReconnectFailed event when
+// reconnecting was stopped by a call to Close.
+var ErrReconnectCancelled = errors.New("reconnect cancelled")
+
+// ErrReconnectGaveUp is reported by the ReconnectFailed event when the
+// ReconnectPolicy refused to make another attempt.
+var ErrReconnectGaveUp = errors.New("reconnect policy gave up")
+
+// ReconnectPolicy decides how long to wait between websocket reconnect
+// attempts and when to stop trying.
+type ReconnectPolicy interface {
+	// NextDelay is called after a failed attempt.
+	// attempt is the number of attempts made so far (starting at 1) and
+	// elapsed is the time spent reconnecting.
+	// It returns the delay before the next attempt, or false to give up.
+	NextDelay(attempt int, elapsed time.Duration) (time.Duration, bool)
+}
+
+// BackoffPolicy is an exponential backoff ReconnectPolicy.
+type BackoffPolicy struct {
+	// Delay before the second attempt
+	InitialDelay time.Duration
+
+	// Upper bound of the delay between attempts
+	MaxDelay time.Duration
+
+	// Factor the delay is multiplied by after every attempt
+	// Values below 1 are treated as 2
+	Multiplier float64
+
+	// Fraction of the delay (0 to 1) randomly added or subtracted
+	Jitter float64
+
+	// Maximum number of attempts, 0 means unlimited
+	MaxAttempts int
+
+	// Maximum time spent reconnecting, 0 means unlimited
+	MaxElapsed time.Duration
+}
+
+// DefaultReconnectPolicy retries forever, doubling the delay from
+// one second up to ten minutes.
+var DefaultReconnectPolicy ReconnectPolicy = &BackoffPolicy{
+	InitialDelay: 1 * time.Second,
+	MaxDelay:     600 * time.Second,
+	Multiplier:   2,
+}
+
+// NextDelay implements ReconnectPolicy.
+func (p *BackoffPolicy) NextDelay(attempt int, elapsed time.Duration) (time.Duration, bool) {
+	if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if p.MaxElapsed > 0 && elapsed >= p.MaxElapsed {
+		return 0, false
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(p.InitialDelay)
+	for i := 1; i < attempt; i++ {
+		delay *= multiplier
+		if p.MaxDelay > 0 && delay >= float64(p.MaxDelay) {
+			delay = float64(p.MaxDelay)
+			break
+		}
+	}
+
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	d := time.Duration(delay)
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d < 0 {
+		d = 0
+	}
+	if p.MaxElapsed > 0 && elapsed+d > p.MaxElapsed {
+		d = p.MaxElapsed - elapsed
+	}
+
+	return d, true
+}
+
+// reconnectPolicy returns the configured policy or the default one.
+func (s *Session) reconnectPolicy() ReconnectPolicy {
+	if s.ReconnectPolicy != nil {
+		return s.ReconnectPolicy
+	}
+	return DefaultReconnectPolicy
+}
@@ -0,0 +1,47 @@
+package guildrone
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffPolicyNextDelay(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    BackoffPolicy
+		attempt   int
+		elapsed   time.Duration
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		{"first", BackoffPolicy{InitialDelay: time.Second, Multiplier: 2}, 1, 0, time.Second, true},
+		{"doubled", BackoffPolicy{InitialDelay: time.Second, Multiplier: 2}, 3, 0, 4 * time.Second, true},
+		{"tripled", BackoffPolicy{InitialDelay: time.Second, Multiplier: 3}, 3, 0, 9 * time.Second, true},
+		{"default multiplier", BackoffPolicy{InitialDelay: time.Second}, 2, 0, 2 * time.Second, true},
+		{"capped", BackoffPolicy{InitialDelay: time.Second, MaxDelay: 5 * time.Second, Multiplier: 2}, 10, 0, 5 * time.Second, true},
+		{"no overflow", BackoffPolicy{InitialDelay: time.Second, MaxDelay: time.Minute, Multiplier: 2}, 1000, 0, time.Minute, true},
+		{"below max attempts", BackoffPolicy{InitialDelay: time.Second, Multiplier: 2, MaxAttempts: 3}, 2, 0, 2 * time.Second, true},
+		{"max attempts", BackoffPolicy{InitialDelay: time.Second, Multiplier: 2, MaxAttempts: 3}, 3, 0, 0, false},
+		{"max elapsed", BackoffPolicy{InitialDelay: time.Second, MaxElapsed: time.Minute}, 1, time.Minute, 0, false},
+		{"shortened by max elapsed", BackoffPolicy{InitialDelay: 10 * time.Second, MaxElapsed: time.Minute}, 1, 55 * time.Second, 5 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := tt.policy.NextDelay(tt.attempt, tt.elapsed)
+			if delay != tt.wantDelay || ok != tt.wantOK {
+				t.Errorf("NextDelay(%d, %s) = %s, %v, want %s, %v", tt.attempt, tt.elapsed, delay, ok, tt.wantDelay, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBackoffPolicyJitter(t *testing.T) {
+	p := BackoffPolicy{InitialDelay: 10 * time.Second, MaxDelay: 12 * time.Second, Multiplier: 2, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		delay, ok := p.NextDelay(1, 0)
+		if !ok || delay < 5*time.Second || delay > 12*time.Second {
+			t.Fatalf("NextDelay(1, 0) = %s, %v, want between 5s and the 12s maximum", delay, ok)
+		}
+	}
+}
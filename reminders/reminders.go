@@ -0,0 +1,409 @@
+// Package reminders sends reminders before calendar events start.
+//
+// A Scheduler creates a job for every reminder of an upcoming event,
+// for example one hour and ten minutes before it starts. Events are
+// loaded from calendar channels with LoadChannel and kept up to date by
+// the calendar event events. Jobs are persisted through a Store, so that
+// reminders are still sent after a restart:
+//
+//	store, err := reminders.OpenFileStore("reminders.json")
+//	if err != nil {
+//		return err
+//	}
+//	r, err := reminders.New(s, store)
+//	if err != nil {
+//		return err
+//	}
+//	r.ChannelID = announcementsChannelID
+//	r.MentionGoing = true
+//	defer r.Start()()
+//
+//	err = r.LoadChannel(calendarChannelID)
+package reminders
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/embedutil"
+)
+
+// ErrNoChannel is reported when a reminder is due and the
+// Scheduler has no ChannelID to send it to.
+var ErrNoChannel = errors.New("no channel to send reminders to")
+
+const (
+	// Number of events requested per page by LoadChannel.
+	loadPageSize = 100
+
+	// Longest content of a chat message, reminders mention as many
+	// of the users going to the event as fit in it.
+	maxContent = 4000
+)
+
+// Scheduler sends reminders of calendar events.
+type Scheduler struct {
+	sync.Mutex
+
+	session *guildrone.Session
+	store   Store
+	jobs    map[string]Job
+	timers  map[string]*time.Timer
+	started bool
+
+	// How long before the start of events reminders are sent.
+	Offsets []time.Duration
+
+	// Chat channel reminders are sent to.
+	// Calendar channels can not hold chat messages.
+	ChannelID guildrone.ChannelID
+
+	// Whether reminders mention the users going to the event.
+	MentionGoing bool
+
+	// Called with the errors of loading events and sending reminders,
+	// ignored if nil.
+	OnError func(err error)
+}
+
+// New creates a Scheduler with the jobs of the store.
+// The jobs are not run before Start is called.
+func New(s *guildrone.Session, store Store) (*Scheduler, error) {
+	jobs, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Scheduler{
+		session: s,
+		store:   store,
+		jobs:    make(map[string]Job, len(jobs)),
+		timers:  map[string]*time.Timer{},
+		Offsets: []time.Duration{time.Hour, 10 * time.Minute},
+	}
+	for _, j := range jobs {
+		r.jobs[j.ID] = j
+	}
+	return r, nil
+}
+
+// Start adds the event handlers of the scheduler to the session and runs
+// the jobs. Jobs due while the scheduler was stopped are run at once.
+// It returns a function removing the handlers and stopping the jobs.
+func (r *Scheduler) Start() func() {
+	r.Lock()
+	r.started = true
+	for _, j := range r.jobs {
+		r.arm(j)
+	}
+	r.Unlock()
+
+	removers := []func(){
+		r.session.AddHandler(r.onCalendarEventCreated),
+		r.session.AddHandler(r.onCalendarEventUpdated),
+		r.session.AddHandler(r.onCalendarEventDeleted),
+	}
+	return func() {
+		for _, remove := range removers {
+			remove()
+		}
+
+		r.Lock()
+		r.started = false
+		for id, t := range r.timers {
+			t.Stop()
+			delete(r.timers, id)
+		}
+		r.Unlock()
+	}
+}
+
+// LoadChannel schedules the reminders of the upcoming events of a calendar channel.
+// Events are requested page by page until a page is short or holds no new event.
+// Each page starts back at the last start time of the previous one, so that
+// events starting at the same time on both sides of a page are not skipped.
+// channelID : The ID of a Channel.
+func (r *Scheduler) LoadChannel(channelID guildrone.ChannelID) error {
+	after := time.Now()
+	seen := map[guildrone.CalendarEventID]bool{}
+	for {
+		events, err := r.session.ChannelEvents(channelID, nil, &after, loadPageSize)
+		if err != nil {
+			return err
+		}
+
+		last := after
+		added := 0
+		for _, e := range events {
+			if seen[e.ID] {
+				continue
+			}
+			seen[e.ID] = true
+			added++
+
+			if err = r.Schedule(e); err != nil {
+				return err
+			}
+			if e.StartsAt.After(last) {
+				last = e.StartsAt
+			}
+		}
+
+		if len(events) < loadPageSize || added == 0 {
+			return nil
+		}
+		// after is exclusive and sent to the second, go back one second
+		// to get the events starting at the same time as the last one.
+		after = last.Truncate(time.Second).Add(-time.Second)
+	}
+}
+
+// Jobs returns the scheduled jobs of an event.
+// eventID : The ID of a CalendarEvent.
+func (r *Scheduler) Jobs(eventID guildrone.CalendarEventID) []Job {
+	r.Lock()
+	defer r.Unlock()
+
+	var jobs []Job
+	for _, j := range r.jobs {
+		if j.EventID == eventID {
+			jobs = append(jobs, j)
+		}
+	}
+	return jobs
+}
+
+// Schedule replaces the jobs of an event with one per offset.
+// Cancelled events and reminders already due are not scheduled.
+func (r *Scheduler) Schedule(e *guildrone.CalendarEvent) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.unschedule(e.ID); err != nil {
+		return err
+	}
+	if e.IsCancelled() {
+		return nil
+	}
+
+	now := time.Now()
+	for _, before := range r.Offsets {
+		j := Job{
+			ID:        jobID(e.ID, before),
+			ServerID:  e.ServerId,
+			ChannelID: e.ChannelId,
+			EventID:   e.ID,
+			StartsAt:  e.StartsAt,
+			Before:    before,
+		}
+		if !j.RemindAt().After(now) {
+			continue
+		}
+
+		if err := r.store.Save(j); err != nil {
+			return err
+		}
+		r.jobs[j.ID] = j
+		if r.started {
+			r.arm(j)
+		}
+	}
+	return nil
+}
+
+// Unschedule deletes the jobs of an event.
+// eventID : The ID of a CalendarEvent.
+func (r *Scheduler) Unschedule(eventID guildrone.CalendarEventID) error {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.unschedule(eventID)
+}
+
+// unschedule deletes the jobs of an event, r must be locked.
+func (r *Scheduler) unschedule(eventID guildrone.CalendarEventID) error {
+	for id, j := range r.jobs {
+		if j.EventID != eventID {
+			continue
+		}
+		if err := r.store.Delete(id); err != nil {
+			return err
+		}
+		delete(r.jobs, id)
+		if t, ok := r.timers[id]; ok {
+			t.Stop()
+			delete(r.timers, id)
+		}
+	}
+	return nil
+}
+
+// arm starts the timer of a job, r must be locked.
+func (r *Scheduler) arm(j Job) {
+	if t, ok := r.timers[j.ID]; ok {
+		t.Stop()
+	}
+	r.timers[j.ID] = time.AfterFunc(time.Until(j.RemindAt()), func() {
+		r.run(j)
+	})
+}
+
+// run sends the reminder of a job and deletes it.
+// The event is fetched again first: reminders of deleted or cancelled
+// events are dropped, and the jobs of events moved while the scheduler
+// was stopped are rescheduled.
+// Nothing is done if the job was rescheduled since its timer was armed.
+func (r *Scheduler) run(j Job) {
+	id := j.ID
+	r.Lock()
+	if cur, ok := r.jobs[id]; !ok || !cur.StartsAt.Equal(j.StartsAt) {
+		r.Unlock()
+		return
+	}
+	delete(r.timers, id)
+	r.Unlock()
+
+	e, err := r.session.ChannelEvent(j.ChannelID, j.EventID)
+	switch {
+	case guildrone.IsNotFound(err):
+		r.report(r.Unschedule(j.EventID))
+		return
+	case err != nil:
+		r.report(err)
+		return
+	case e.IsCancelled():
+		r.report(r.Unschedule(j.EventID))
+		return
+	case !e.StartsAt.Equal(j.StartsAt):
+		r.report(r.Schedule(e))
+		return
+	}
+
+	if time.Now().Before(e.StartsAt) {
+		r.report(r.send(e))
+	}
+
+	// The event may have been rescheduled while the reminder was sent,
+	// its new job is kept.
+	r.Lock()
+	if cur, ok := r.jobs[id]; ok && cur.StartsAt.Equal(j.StartsAt) {
+		delete(r.jobs, id)
+		err = r.store.Delete(id)
+	}
+	r.Unlock()
+	r.report(err)
+}
+
+// send sends the reminder of the event.
+func (r *Scheduler) send(e *guildrone.CalendarEvent) error {
+	r.Lock()
+	channelID, mentionGoing := r.ChannelID, r.MentionGoing
+	r.Unlock()
+	if channelID == "" {
+		return ErrNoChannel
+	}
+
+	msg := &guildrone.MessageCreate{
+		Embeds: []guildrone.ChatEmbed{reminderEmbed(e)},
+	}
+
+	if mentionGoing {
+		rsvps, err := r.session.ChannelEventRsvps(e.ChannelId, e.ID)
+		if err != nil {
+			return err
+		}
+		var b strings.Builder
+		for _, rsvp := range rsvps {
+			mention := "<@" + string(rsvp.UserID) + "> "
+			if rsvp.Status != guildrone.RsvpStatusGoing || b.Len()+len(mention) > maxContent {
+				continue
+			}
+			b.WriteString(mention)
+		}
+		msg.Content = strings.TrimSpace(b.String())
+	}
+
+	_, err := r.session.ChannelMessageCreateComplex(channelID, msg)
+	return err
+}
+
+func (r *Scheduler) onCalendarEventCreated(s *guildrone.Session, e *guildrone.CalendarEventCreated) {
+	r.report(r.Schedule(&e.CalendarEvent))
+}
+
+func (r *Scheduler) onCalendarEventUpdated(s *guildrone.Session, e *guildrone.CalendarEventUpdated) {
+	r.report(r.Schedule(&e.CalendarEvent))
+}
+
+func (r *Scheduler) onCalendarEventDeleted(s *guildrone.Session, e *guildrone.CalendarEventDeleted) {
+	r.report(r.Unschedule(e.CalendarEvent.ID))
+}
+
+func (r *Scheduler) report(err error) {
+	if err == nil {
+		return
+	}
+
+	r.Lock()
+	onError := r.OnError
+	r.Unlock()
+	if onError != nil {
+		onError(err)
+	}
+}
+
+// reminderEmbed returns the reminder of the event.
+func reminderEmbed(e *guildrone.CalendarEvent) guildrone.ChatEmbed {
+	starts := e.StartsAt
+	fields := []guildrone.ChatEmbedField{
+		{Name: "Starts", Value: starts.UTC().Format("2006-01-02 15:04 MST"), Inline: true},
+	}
+	if e.Duration > 0 {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Duration", Value: formatDuration(time.Duration(e.Duration) * time.Minute), Inline: true})
+	}
+	if e.Location != "" {
+		fields = append(fields, guildrone.ChatEmbedField{Name: "Location", Value: e.Location, Inline: true})
+	}
+
+	description := e.Description
+	description = embedutil.Truncate(description, embedutil.MaxDescription)
+
+	return guildrone.ChatEmbed{
+		Title:       fmt.Sprintf("%s starts in %s", e.Name, formatDuration(time.Until(starts))),
+		Description: description,
+		URL:         e.URL,
+		Color:       embedutil.ColorInfo,
+		Fields:      fields,
+		Timestamp:   &starts,
+	}
+}
+
+// formatDuration formats a duration in days, hours and minutes.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "less than a minute"
+	}
+
+	var parts []string
+	if days := d / (24 * time.Hour); days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+		d -= days * 24 * time.Hour
+	}
+	if hours := d / time.Hour; hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+		d -= hours * time.Hour
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
+
+func jobID(eventID guildrone.CalendarEventID, before time.Duration) string {
+	return eventID.String() + "/" + before.String()
+}
@@ -0,0 +1,175 @@
+package reminders
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "less than a minute"},
+		{29 * time.Second, "less than a minute"},
+		{30 * time.Second, "1m"},
+		{10 * time.Minute, "10m"},
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h 30m"},
+		{24 * time.Hour, "1d"},
+		{49*time.Hour + 5*time.Minute, "2d 1h 5m"},
+		{24*time.Hour + 59*time.Minute + 40*time.Second, "1d 1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.d.String(), func(t *testing.T) {
+			if got := formatDuration(tt.d); got != tt.want {
+				t.Errorf("formatDuration(%s) = %q, want %q", tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	startsAt := time.Now().Add(30 * time.Minute)
+	tests := []struct {
+		name  string
+		event *guildrone.CalendarEvent
+		want  []time.Duration
+	}{
+		{"upcoming", &guildrone.CalendarEvent{ID: 1, StartsAt: startsAt.Add(2 * time.Hour)}, []time.Duration{time.Hour, 10 * time.Minute}},
+		{"first reminder due", &guildrone.CalendarEvent{ID: 1, StartsAt: startsAt}, []time.Duration{10 * time.Minute}},
+		{"cancelled", &guildrone.CalendarEvent{ID: 1, StartsAt: startsAt, Cancellation: &guildrone.Cancellation{}}, nil},
+		{"started", &guildrone.CalendarEvent{ID: 1, StartsAt: time.Now().Add(-time.Minute)}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			r, err := New(nil, store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// An earlier schedule of the event is replaced.
+			if err = r.Schedule(&guildrone.CalendarEvent{ID: 1, StartsAt: startsAt.Add(24 * time.Hour)}); err != nil {
+				t.Fatal(err)
+			}
+			if err = r.Schedule(tt.event); err != nil {
+				t.Fatalf("Schedule() error = %v", err)
+			}
+
+			jobs, err := store.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != len(tt.want) || len(r.Jobs(1)) != len(tt.want) {
+				t.Fatalf("Schedule() saved %d jobs, want %d", len(jobs), len(tt.want))
+			}
+			for i, j := range jobs {
+				if j.Before != tt.want[i] || !j.StartsAt.Equal(tt.event.StartsAt) {
+					t.Errorf("job %d = %s before %s, want %s before %s", i, j.Before, j.StartsAt, tt.want[i], tt.event.StartsAt)
+				}
+			}
+		})
+	}
+}
+
+// calendarTransport serves the events starting after the after parameter,
+// to the second, like the Guilded API.
+type calendarTransport struct {
+	events []*guildrone.CalendarEvent
+	pages  int
+}
+
+func (c *calendarTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.pages++
+	q := req.URL.Query()
+	after, err := time.Parse(time.RFC3339, q.Get("after"))
+	if err != nil {
+		return nil, err
+	}
+	limit, _ := strconv.Atoi(q.Get("limit"))
+
+	var page []*guildrone.CalendarEvent
+	for _, e := range c.events {
+		if e.StartsAt.Truncate(time.Second).After(after) && len(page) < limit {
+			page = append(page, e)
+		}
+	}
+	body, err := json.Marshal(map[string]interface{}{"calendarEvents": page})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestLoadChannel(t *testing.T) {
+	start := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name      string
+		starts    []time.Duration
+		want      int
+		wantPages int
+	}{
+		{"one page", []time.Duration{0, time.Minute}, 2, 1},
+		{"exact pages", repeatedStarts(loadPageSize, time.Minute), loadPageSize, 2},
+		{"same start across pages", append(repeatedStarts(loadPageSize, time.Minute), (loadPageSize-1)*time.Minute), loadPageSize + 1, 2},
+		// The events past a full page starting in the same second
+		// can not be requested.
+		{"full page at one start", repeatedStarts(loadPageSize+1, 0), loadPageSize, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &calendarTransport{}
+			for i, d := range tt.starts {
+				transport.events = append(transport.events, &guildrone.CalendarEvent{ID: guildrone.CalendarEventID(i + 1), StartsAt: start.Add(d)})
+			}
+			sort.SliceStable(transport.events, func(i, j int) bool {
+				return transport.events[i].StartsAt.Before(transport.events[j].StartsAt)
+			})
+
+			s := &guildrone.Session{Client: &http.Client{Transport: transport}}
+			r, err := New(s, NewMemoryStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Offsets = []time.Duration{time.Hour}
+
+			if err = r.LoadChannel("c1"); err != nil {
+				t.Fatalf("LoadChannel() error = %v", err)
+			}
+			for i := 1; i <= tt.want; i++ {
+				if len(r.Jobs(guildrone.CalendarEventID(i))) != 1 {
+					t.Errorf("event %d not scheduled", i)
+				}
+			}
+			if transport.pages != tt.wantPages {
+				t.Errorf("LoadChannel() requested %d pages, want %d", transport.pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+// repeatedStarts returns n start offsets step apart.
+func repeatedStarts(n int, step time.Duration) []time.Duration {
+	starts := make([]time.Duration, n)
+	for i := range starts {
+		starts[i] = time.Duration(i) * step
+	}
+	return starts
+}
@@ -0,0 +1,181 @@
+package reminders
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FlameInTheDark/guildrone"
+	"github.com/FlameInTheDark/guildrone/internal/fileutil"
+)
+
+// Job is a reminder to send for a calendar event.
+type Job struct {
+	ID string `json:"id"`
+
+	ServerID  guildrone.ServerID        `json:"serverId"`
+	ChannelID guildrone.ChannelID       `json:"channelId"` // Calendar channel of the event
+	EventID   guildrone.CalendarEventID `json:"eventId"`
+
+	// Start of the event when the job was scheduled
+	StartsAt time.Time `json:"startsAt"`
+
+	// How long before the start the reminder is sent
+	Before time.Duration `json:"before"`
+}
+
+// RemindAt returns when the reminder is due.
+func (j *Job) RemindAt() time.Time {
+	return j.StartsAt.Add(-j.Before)
+}
+
+// Store persists the jobs of a Scheduler so that reminders
+// survive restarts.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns every saved job.
+	Load() ([]Job, error)
+
+	// Save saves a job, replacing the one with the same ID.
+	Save(j Job) error
+
+	// Delete deletes the job with the ID.
+	Delete(id string) error
+}
+
+// MemoryStore is a Store keeping jobs in memory.
+type MemoryStore struct {
+	mu   sync.Mutex
+	jobs map[string]Job
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: map[string]Job{}}
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load() ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedJobs(s.jobs), nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(j Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[j.ID] = j
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.jobs, id)
+	return nil
+}
+
+// FileStore is a Store keeping jobs in a JSON file.
+// The file is rewritten on every change.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	jobs map[string]Job
+}
+
+// OpenFileStore opens the JSON file at path.
+// The file is created on the first change if it does not exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, jobs: map[string]Job{}}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []Job
+	if err = json.Unmarshal(b, &jobs); err != nil {
+		return nil, err
+	}
+	for _, j := range jobs {
+		s.jobs[j.ID] = j
+	}
+	return s, nil
+}
+
+// Load implements Store.
+func (s *FileStore) Load() ([]Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedJobs(s.jobs), nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(j Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, existed := s.jobs[j.ID]
+	s.jobs[j.ID] = j
+	if err := s.write(); err != nil {
+		if existed {
+			s.jobs[j.ID] = old
+		} else {
+			delete(s.jobs, j.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, ok := s.jobs[id]
+	if !ok {
+		return nil
+	}
+	delete(s.jobs, id)
+	if err := s.write(); err != nil {
+		s.jobs[id] = old
+		return err
+	}
+	return nil
+}
+
+// write replaces the file with the current jobs, s.mu must be locked.
+func (s *FileStore) write() error {
+	b, err := json.MarshalIndent(sortedJobs(s.jobs), "", "\t")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteAtomic(s.path, b)
+}
+
+// sortedJobs returns the jobs ordered by due time.
+func sortedJobs(m map[string]Job) []Job {
+	jobs := make([]Job, 0, len(m))
+	for _, j := range m {
+		jobs = append(jobs, j)
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		if a, b := jobs[i].RemindAt(), jobs[k].RemindAt(); !a.Equal(b) {
+			return a.Before(b)
+		}
+		return jobs[i].ID < jobs[k].ID
+	})
+	return jobs
+}
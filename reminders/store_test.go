@@ -0,0 +1,74 @@
+package reminders
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSortedJobs(t *testing.T) {
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	hourBefore := Job{ID: jobID(1, time.Hour), EventID: 1, StartsAt: at, Before: time.Hour}
+	tenBefore := Job{ID: jobID(1, 10*time.Minute), EventID: 1, StartsAt: at, Before: 10 * time.Minute}
+	earlier := Job{ID: jobID(2, time.Hour), EventID: 2, StartsAt: at.Add(-time.Hour), Before: time.Hour}
+	sameDue := Job{ID: jobID(3, 10*time.Minute), EventID: 3, StartsAt: at.Add(-50 * time.Minute), Before: 10 * time.Minute}
+
+	tests := []struct {
+		name string
+		jobs []Job
+		want []Job
+	}{
+		{"empty", nil, []Job{}},
+		{"by due time, not start", []Job{tenBefore, hourBefore, earlier}, []Job{earlier, hourBefore, tenBefore}},
+		{"same due time by ID", []Job{sameDue, hourBefore}, []Job{hourBefore, sameDue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := map[string]Job{}
+			for _, j := range tt.jobs {
+				m[j.ID] = j
+			}
+			if got := sortedJobs(m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortedJobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reminders.json")
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kept := Job{ID: jobID(1, time.Hour), ServerID: "s1", ChannelID: "c1", EventID: 1, StartsAt: at, Before: time.Hour}
+	deleted := Job{ID: jobID(2, time.Hour), ServerID: "s1", ChannelID: "c1", EventID: 2, StartsAt: at, Before: time.Hour}
+	for _, j := range []Job{kept, deleted} {
+		if err = s.Save(j); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if err = s.Delete(deleted.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err = s.Delete("missing"); err != nil {
+		t.Fatalf("Delete() of a missing job error = %v", err)
+	}
+
+	// Jobs keep their start time and offset across a restart.
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	got, err := reopened.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != kept.ID || !got[0].RemindAt().Equal(kept.RemindAt()) || got[0].Before != kept.Before {
+		t.Errorf("reopened Load() = %v, want %v", got, []Job{kept})
+	}
+}
@@ -1,14 +1,21 @@
 package guildrone
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +25,7 @@ import (
 var (
 	ErrJSONUnmarshal = errors.New("json unmarshal")
 	ErrUnauthorized  = errors.New("HTTP request was unauthorized. This could be because the provided token was not a bot token")
+	ErrFileTooLarge  = errors.New("file is larger than the upload size limit")
 )
 
 var (
@@ -95,12 +103,19 @@ func (s *Session) request(method, urlStr, contentType string, b []byte, sequence
 
 // RequestCall makes a request using a bucket that's already been locked
 func (s *Session) RequestCall(method, urlStr, contentType string, b []byte, sequence int) (response []byte, err error) {
+	return s.requestCall(context.Background(), method, urlStr, contentType, b, sequence, s.ShouldRetryOnRateLimit)
+}
+
+// requestCall makes a request that is cancelled when ctx is done.
+// When rate limited it waits and retries if retryRateLimit is true,
+// otherwise it returns a RateLimitError.
+func (s *Session) requestCall(ctx context.Context, method, urlStr, contentType string, b []byte, sequence int, retryRateLimit bool) (response []byte, err error) {
 	if s.Debug {
 		log.Printf("API REQUEST %8s :: %s\n", method, urlStr)
 		log.Printf("API REQUEST  PAYLOAD :: [%s]\n", string(b))
 	}
 
-	req, err := http.NewRequest(method, urlStr, bytes.NewBuffer(b))
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, bytes.NewBuffer(b))
 	if err != nil {
 		//bucket.Release(nil)
 		return
@@ -166,26 +181,26 @@ func (s *Session) RequestCall(method, urlStr, contentType string, b []byte, sequ
 		if sequence < s.MaxRestRetries {
 
 			s.log(LogInformational, "%s Failed (%s), Retrying...", urlStr, resp.Status)
-			response, err = s.RequestCall(method, urlStr, contentType, b, sequence+1)
+			response, err = s.requestCall(ctx, method, urlStr, contentType, b, sequence+1, retryRateLimit)
 		} else {
 			err = fmt.Errorf("Exceeded Max retries HTTP %s, %s", resp.Status, response)
 		}
 	case 429: // TOO MANY REQUESTS - Rate limiting
-		after, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
-		if err != nil {
-			return nil, err
+		after, perr := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if perr != nil {
+			return nil, perr
 		}
 
-		if s.ShouldRetryOnRateLimit {
+		if retryRateLimit {
 
 			s.log(LogInformational, "Rate Limiting %s, retry in %d", urlStr, after)
-			s.handleEvent(rateLimitEventType, &RateLimit{RetryAfter: time.Duration(after), URL: urlStr})
+			s.handleEvent(rateLimitEventType, &RateLimit{RetryAfter: time.Duration(after) * time.Second, URL: urlStr})
 
-			time.Sleep(time.Duration(after))
+			time.Sleep(time.Duration(after) * time.Second)
 
-			response, err = s.RequestCall(method, urlStr, contentType, b, sequence)
+			response, err = s.requestCall(ctx, method, urlStr, contentType, b, sequence, retryRateLimit)
 		} else {
-			err = &RateLimitError{&RateLimit{RetryAfter: time.Duration(after), URL: urlStr}}
+			err = &RateLimitError{&RateLimit{RetryAfter: time.Duration(after) * time.Second, URL: urlStr}}
 		}
 	case http.StatusUnauthorized:
 		if strings.Index(s.Token, "Bot ") != 0 {
@@ -200,6 +215,191 @@ func (s *Session) RequestCall(method, urlStr, contentType string, b []byte, sequ
 	return
 }
 
+// requestStream sends a request with a body read as it is sent, like a
+// multipart upload. The body cannot be sent twice, so the request is not
+// retried and a RateLimitError is returned when rate limited.
+func (s *Session) requestStream(method, urlStr, contentType string, body io.Reader) (response []byte, err error) {
+	if s.Debug {
+		log.Printf("API REQUEST %8s :: %s\n", method, urlStr)
+		log.Printf("API REQUEST  PAYLOAD :: [%s streamed]\n", contentType)
+	}
+
+	req, err := http.NewRequest(method, urlStr, body)
+	if err != nil {
+		return
+	}
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", s.UserAgent)
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err2 := resp.Body.Close()
+		if s.Debug && err2 != nil {
+			log.Println("error closing resp body")
+		}
+	}()
+
+	response, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
+	if s.Debug {
+		log.Printf("API RESPONSE  STATUS :: %s\n", resp.Status)
+		log.Printf("API RESPONSE    BODY :: [%s]\n\n\n", response)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	case http.StatusTooManyRequests:
+		after, _ := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		err = &RateLimitError{&RateLimit{RetryAfter: time.Duration(after) * time.Second, URL: urlStr}}
+	default:
+		err = newRestError(req, resp, response)
+	}
+	return
+}
+
+// MediaType is the kind of media uploaded with MediaUpload.
+type MediaType string
+
+// Media types accepted by the Guilded media server
+const (
+	MediaTypeContent MediaType = "ContentMediaGenericFiles"
+	MediaTypeEmote   MediaType = "CustomReaction"
+	MediaTypeAvatar  MediaType = "UserAvatar"
+	MediaTypeBanner  MediaType = "UserBanner"
+)
+
+// DefaultMaxUploadSize is the default Session.MaxUploadSize and WebhookClient.MaxUploadSize.
+const DefaultMaxUploadSize int64 = 25 << 20
+
+// File is a file attached to a multipart request.
+type File struct {
+	Name        string
+	ContentType string
+	Reader      io.Reader
+}
+
+// multipartBody streams a multipart/form-data body with the JSON payload
+// in the "payload_json" field followed by the files, each at most limit
+// bytes, 0 means unlimited.
+// Returns the content type of the body, including its boundary.
+func multipartBody(payload interface{}, files []*File, limit int64) (contentType string, body io.ReadCloser, err error) {
+	var p []byte
+	if payload != nil {
+		if p, err = Marshal(payload); err != nil {
+			return
+		}
+	}
+
+	contentType, body = multipartPipe(func(mw *multipart.Writer) error {
+		if p != nil {
+			h := make(textproto.MIMEHeader)
+			h.Set("Content-Disposition", `form-data; name="payload_json"`)
+			h.Set("Content-Type", "application/json")
+			w, err := mw.CreatePart(h)
+			if err != nil {
+				return err
+			}
+			if _, err = w.Write(p); err != nil {
+				return err
+			}
+		}
+
+		for i, f := range files {
+			if err := writeFilePart(mw, fmt.Sprintf("files[%d]", i), f, limit); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// multipartPipe returns a reader streaming the multipart/form-data body
+// written by write, and the content type of the body.
+// The reader returns the error of write, it must be closed once done with.
+func multipartPipe(write func(mw *multipart.Writer) error) (contentType string, body io.ReadCloser) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := write(mw)
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return mw.FormDataContentType(), pr
+}
+
+// writeFilePart writes the file as a part of a multipart body.
+// Returns ErrFileTooLarge if the file is larger than limit bytes, 0 means unlimited.
+func writeFilePart(mw *multipart.Writer, field string, f *File, limit int64) error {
+	var src io.Reader = f.Reader
+	if limit > 0 {
+		src = &sizeLimitReader{r: src, n: limit}
+	}
+
+	r := bufio.NewReader(src)
+	ct := f.ContentType
+	if ct == "" {
+		ct = detectContentType(f.Name, r)
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, field, quoteEscaper.Replace(f.Name)))
+	h.Set("Content-Type", ct)
+	w, err := mw.CreatePart(h)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// sizeLimitReader fails with ErrFileTooLarge once more than n bytes are read.
+type sizeLimitReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, ErrFileTooLarge
+	}
+	return n, err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// detectContentType returns the content type of a file from its extension,
+// or by sniffing the first bytes of r without consuming them.
+func detectContentType(name string, r *bufio.Reader) string {
+	if ct := mime.TypeByExtension(filepath.Ext(name)); ct != "" {
+		return ct
+	}
+
+	head, _ := r.Peek(512)
+	return http.DetectContentType(head)
+}
+
+// IsNotFound returns true if err is a REST error with a 404 status,
+// like when the requested object does not exist or was deleted.
+func IsNotFound(err error) bool {
+	var restErr *RESTError
+	return errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound
+}
+
 func unmarshal(data []byte, v interface{}) error {
 	err := Unmarshal(data, v)
 	if err != nil {
@@ -216,7 +416,7 @@ func unmarshal(data []byte, v interface{}) error {
 // ChannelMessageCreateComplex sends a message to the given channel.
 // channelID : The ID of a Channel.
 // data      : The message struct to send.
-func (s *Session) ChannelMessageCreateComplex(channelID string, data *MessageCreate) (st *ChatMessage, err error) {
+func (s *Session) ChannelMessageCreateComplex(channelID ChannelID, data *MessageCreate) (st *ChatMessage, err error) {
 	body, err := s.Request("POST", EndpointChannelMessages(channelID), data)
 	if err != nil {
 		return
@@ -229,7 +429,7 @@ func (s *Session) ChannelMessageCreateComplex(channelID string, data *MessageCre
 // ChannelMessageCreate sends a message to the given channel.
 // channelID : The ID of a Channel.
 // content   : The message to send.
-func (s *Session) ChannelMessageCreate(channelID string, content string) (*ChatMessage, error) {
+func (s *Session) ChannelMessageCreate(channelID ChannelID, content string) (*ChatMessage, error) {
 	return s.ChannelMessageCreateComplex(channelID, &MessageCreate{
 		Content: content,
 	})
@@ -238,7 +438,7 @@ func (s *Session) ChannelMessageCreate(channelID string, content string) (*ChatM
 // ChannelMessage returns a message from a channel.
 // channelID : The ID of a Channel.
 // messageID : The ID of a Message.
-func (s *Session) ChannelMessage(channelID string, messageID string) (*ChatMessage, error) {
+func (s *Session) ChannelMessage(channelID ChannelID, messageID MessageID) (*ChatMessage, error) {
 	body, err := s.Request("GET", EndpointChannelMessage(channelID, messageID), nil)
 	if err != nil {
 		return nil, err
@@ -255,7 +455,7 @@ func (s *Session) ChannelMessage(channelID string, messageID string) (*ChatMessa
 // beforeTime     : The time before which messages are to be returned.
 // afterTime      : The time after which messages are to be returned.
 // includePrivate : Whether to include private messages.
-func (s *Session) ChannelMessages(channelID string, limit int, beforeTime, afterTime *time.Time, includePrivate bool) ([]*ChatMessage, error) {
+func (s *Session) ChannelMessages(channelID ChannelID, limit int, beforeTime, afterTime *time.Time, includePrivate bool) ([]*ChatMessage, error) {
 	uri := EndpointChannelMessages(channelID)
 	v := url.Values{}
 	if limit > 0 {
@@ -288,7 +488,7 @@ func (s *Session) ChannelMessages(channelID string, limit int, beforeTime, after
 // channelID : The ID of a Channel.
 // messageID : The ID of a Message.
 // data      : The message struct to send.
-func (s *Session) ChannelMessageUpdate(channelID, messageID string, data *MessageUpdate) (*ChatMessage, error) {
+func (s *Session) ChannelMessageUpdate(channelID ChannelID, messageID MessageID, data *MessageUpdate) (*ChatMessage, error) {
 	body, err := s.Request("PUT", EndpointChannelMessage(channelID, messageID), data)
 	if err != nil {
 		return nil, err
@@ -302,7 +502,7 @@ func (s *Session) ChannelMessageUpdate(channelID, messageID string, data *Messag
 // ChannelMessageDelete deletes a message in a channel.
 // channelID : The ID of a Channel.
 // messageID : The ID of a Message.
-func (s *Session) ChannelMessageDelete(channelID, messageID string) error {
+func (s *Session) ChannelMessageDelete(channelID ChannelID, messageID MessageID) error {
 	_, err := s.Request("DELETE", EndpointChannelMessage(channelID, messageID), nil)
 	return err
 }
@@ -311,7 +511,7 @@ func (s *Session) ChannelMessageDelete(channelID, messageID string) error {
 // Functions specific to Guilded Servers
 // ------------------------------------------------------------------------------------------------
 
-func (s *Session) ServerGet(serverID string) (*Server, error) {
+func (s *Session) ServerGet(serverID ServerID) (*Server, error) {
 	body, err := s.Request("GET", EndpointServer(serverID), nil)
 	if err != nil {
 		return nil, err
@@ -322,6 +522,62 @@ func (s *Session) ServerGet(serverID string) (*Server, error) {
 	return st, err
 }
 
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Users
+// ------------------------------------------------------------------------------------------------
+
+// UserGet returns a user.
+// userID : The ID of a User, or "@me" for the bot user.
+func (s *Session) UserGet(userID UserID) (*User, error) {
+	body, err := s.Request("GET", EndpointUser(userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		User *User `json:"user"`
+	}
+	err = unmarshal(body, &st)
+	return st.User, err
+}
+
+// UserMe returns the user of the bot.
+func (s *Session) UserMe() (*User, error) {
+	return s.UserGet("@me")
+}
+
+// UserServers returns an array of the servers a user is a member of.
+// Bots can only list their own servers.
+// userID : The ID of a User, or "@me" for the bot user.
+func (s *Session) UserServers(userID UserID) ([]*Server, error) {
+	body, err := s.Request("GET", EndpointUserServers(userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Servers []*Server `json:"servers"`
+	}
+	err = unmarshal(body, &st)
+	return st.Servers, err
+}
+
+// UserStatusUpdate sets the custom status of a user.
+// Bots can only set their own status.
+// userID : The ID of a User, or "@me" for the bot user.
+// data   : The status to set.
+func (s *Session) UserStatusUpdate(userID UserID, data *UserStatusUpdate) error {
+	_, err := s.Request("PUT", EndpointUserStatus(userID), data)
+	return err
+}
+
+// UserStatusDelete clears the custom status of a user.
+// userID : The ID of a User, or "@me" for the bot user.
+func (s *Session) UserStatusDelete(userID UserID) error {
+	_, err := s.Request("DELETE", EndpointUserStatus(userID), nil)
+	return err
+}
+
 // ------------------------------------------------------------------------------------------------
 // Functions specific to Guilded Channels
 // ------------------------------------------------------------------------------------------------
@@ -341,7 +597,7 @@ func (s *Session) ChannelCreate(data *ServerChannelCreate) (*ServerChannel, erro
 
 // ChannelGet returns a channel.
 // channelID : The ID of a Channel.
-func (s *Session) ChannelGet(channelID string) (*ServerChannel, error) {
+func (s *Session) ChannelGet(channelID ChannelID) (*ServerChannel, error) {
 	body, err := s.Request("GET", EndpointChannel(channelID), nil)
 	if err != nil {
 		return nil, err
@@ -355,7 +611,7 @@ func (s *Session) ChannelGet(channelID string) (*ServerChannel, error) {
 // ServerChannelUpdate updates a channel.
 // channelID : The ID of a Channel.
 // data      : The channel struct to send.
-func (s *Session) ChannelUpdate(channelID string, data *ServerChannelUpdate) (*ServerChannel, error) {
+func (s *Session) ChannelUpdate(channelID ChannelID, data *ServerChannelUpdate) (*ServerChannel, error) {
 	body, err := s.Request("PATCH", EndpointChannel(channelID), data)
 	if err != nil {
 		return nil, err
@@ -368,676 +624,2044 @@ func (s *Session) ChannelUpdate(channelID string, data *ServerChannelUpdate) (*S
 
 // ChannelDelete deletes a channel.
 // channelID : The ID of a Channel.
-func (s *Session) ChannelDelete(channelID string) error {
+func (s *Session) ChannelDelete(channelID ChannelID) error {
 	_, err := s.Request("DELETE", EndpointChannel(channelID), nil)
 	return err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Members
-// ------------------------------------------------------------------------------------------------
-
-// ServerMemberGet returns a member of the server.
+// ServerChannels returns an array of the channels of a server.
 // serverID : The ID of a Server.
-// userID   : The ID of a User.
-func (s *Session) ServerMemberGet(serverID, userID string) (*ServerMember, error) {
-	body, err := s.Request("GET", EndpointServerMember(serverID, userID), nil)
+func (s *Session) ServerChannels(serverID ServerID) ([]*ServerChannel, error) {
+	body, err := s.Request("GET", EndpointServerChannels(serverID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ServerMember
+	var st struct {
+		Channels []*ServerChannel `json:"channels"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
-}
-
-// ServerMemberKick kicks a member from a server.
-// serverID : The ID of a Server.
-// userID   : The ID of a User.
-func (s *Session) ServerMemberKick(serverID, userID string) error {
-	_, err := s.Request("DELETE", EndpointServerMember(serverID, userID), nil)
-	return err
+	return st.Channels, err
 }
 
-// ServerMembers returns an array of members of a server.
-// serverID : The ID of a Server.
-func (s *Session) ServerMembers(serverID string) ([]*ServerMember, error) {
-	body, err := s.Request("GET", EndpointServerMembers(serverID), nil)
+// ChannelArchive archives a channel.
+// The returned channel has ArchivedAt and ArchivedBy set.
+// channelID : The ID of a Channel.
+func (s *Session) ChannelArchive(channelID ChannelID) (*ServerChannel, error) {
+	_, err := s.Request("PUT", EndpointChannelArchive(channelID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*ServerMember
-	err = unmarshal(body, &st)
-	return st, err
+	return s.ChannelGet(channelID)
 }
 
-// ServerMemberNicknameUpdate updates a member's nickname in a server.
-// serverID : The ID of a Server.
-// userID   : The ID of a User.
-// nickname : The nickname to set.
-func (s *Session) ServerMemberNicknameUpdate(serverID, userID string, nickname string) (string, error) {
-	body, err := s.Request("PUT", EndpointServerMemberNickname(serverID, userID), &ServerMemberNicknameUpdate{
-		Nickname: nickname,
-	})
+// ChannelRestore restores an archived channel.
+// channelID : The ID of a Channel.
+func (s *Session) ChannelRestore(channelID ChannelID) (*ServerChannel, error) {
+	_, err := s.Request("DELETE", EndpointChannelArchive(channelID), nil)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var st ServerMemberNicknameUpdate
-	err = unmarshal(body, &st)
-	return st.Nickname, err
-}
-
-// ServerMemberNicknameDelete deletes a member's nickname in a server.
-// serverID : The ID of a Server.
-// userID   : The ID of a User.
-func (s *Session) ServerMemberNicknameDelete(serverID, userID string) error {
-	_, err := s.Request("DELETE", EndpointServerMemberNickname(serverID, userID), nil)
-	return err
+	return s.ChannelGet(channelID)
 }
 
 // ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Member Bans
+// Functions specific to Guilded Groups
 // ------------------------------------------------------------------------------------------------
 
-// ServerMemberBanCreate creates a ban on a member of a server.
+// ServerGroupCreate creates a group in a server.
 // serverID : The ID of a Server.
-// userID   : The ID of a User.
-// reason   : The reason for the ban.
-func (s *Session) ServerMemberBanCreate(serverID, userID, reason string) (*ServerMemberBan, error) {
-	body, err := s.Request("POST", EndpointServerBansMember(serverID, userID), &ServerMemberBanCreate{
-		Reason: reason,
-	})
+// data     : The data for the group.
+func (s *Session) ServerGroupCreate(serverID ServerID, data *ServerGroupCreate) (*Group, error) {
+	body, err := s.Request("POST", EndpointServerGroups(serverID), data)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ServerMemberBan
+	var st struct {
+		Group *Group `json:"group"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Group, err
 }
 
-// ServerMemberBan returns a ban on a member of a server.
+// ServerGroups returns an array of the groups of a server.
 // serverID : The ID of a Server.
-// userID   : The ID of a User.
-func (s *Session) ServerMemberBan(serverID, userID string) (*ServerMemberBan, error) {
-	body, err := s.Request("GET", EndpointServerBansMember(serverID, userID), nil)
+func (s *Session) ServerGroups(serverID ServerID) ([]*Group, error) {
+	body, err := s.Request("GET", EndpointServerGroups(serverID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ServerMemberBan
+	var st struct {
+		Groups []*Group `json:"groups"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Groups, err
 }
 
-// ServerMemberBanDelete deletes a ban on a member of a server.
+// ServerGroup returns a group of a server.
 // serverID : The ID of a Server.
-// userID   : The ID of a User.
-func (s *Session) ServerMemberBanDelete(serverID, userID string) error {
-	_, err := s.Request("DELETE", EndpointServerBansMember(serverID, userID), nil)
-	return err
+// groupID  : The ID of a Group.
+func (s *Session) ServerGroup(serverID ServerID, groupID GroupID) (*Group, error) {
+	body, err := s.Request("GET", EndpointServerGroup(serverID, groupID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Group *Group `json:"group"`
+	}
+	err = unmarshal(body, &st)
+	return st.Group, err
 }
 
-// ServerMemberBans returns an array of bans on a member of a server.
+// ServerGroupUpdate updates a group of a server.
 // serverID : The ID of a Server.
-func (s *Session) ServerMemberBans(serverID string) ([]*ServerMemberBan, error) {
-	body, err := s.Request("GET", EndpointServerBans(serverID), nil)
+// groupID  : The ID of a Group.
+// data     : The data for the group.
+func (s *Session) ServerGroupUpdate(serverID ServerID, groupID GroupID, data *ServerGroupUpdate) (*Group, error) {
+	body, err := s.Request("PATCH", EndpointServerGroup(serverID, groupID), data)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*ServerMemberBan
+	var st struct {
+		Group *Group `json:"group"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Group, err
+}
+
+// ServerGroupDelete deletes a group of a server.
+// serverID : The ID of a Server.
+// groupID  : The ID of a Group.
+func (s *Session) ServerGroupDelete(serverID ServerID, groupID GroupID) error {
+	_, err := s.Request("DELETE", EndpointServerGroup(serverID, groupID), nil)
+	return err
 }
 
 // ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Forums
+// Functions specific to Guilded Categories
 // ------------------------------------------------------------------------------------------------
 
-// ChannelForumTopicCreate creates a topic in a channel.
-// channelID : The ID of a Channel.
-// title     : The title of the topic.
-// content   : The content of the topic.
-func (s *Session) ChannelForumTopicCreate(channelID, title, content string) (*ForumTopic, error) {
-	body, err := s.Request("POST", EndpointChannelTopics(channelID), &ChannelForumTopicCreate{
-		Title:   title,
-		Content: content,
-	})
+// ServerCategoryCreate creates a channel category in a server.
+// serverID : The ID of a Server.
+// data     : The data for the category.
+func (s *Session) ServerCategoryCreate(serverID ServerID, data *ServerCategoryCreate) (*Category, error) {
+	body, err := s.Request("POST", EndpointServerCategories(serverID), data)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ForumTopic
+	var st struct {
+		Category *Category `json:"category"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Category, err
 }
 
-// ChannelForumTopics returns an array of topics in a channel.
-// channelID : The ID of a Channel.
-// before    : The timestamp of the oldest topic to return.
-// limit     : The maximum number of topics to return.
-func (s *Session) ChannelForumTopics(channelID string, before *time.Time, limit int) ([]ForumTopicSummary, error) {
-	uri := EndpointChannelTopics(channelID)
-	v := url.Values{}
-	if limit > 0 {
-		v.Set("limit", strconv.Itoa(limit))
-	}
-	if before != nil {
-		v.Set("before", before.Format(time.RFC3339))
-	}
-	if len(v) > 0 {
-		uri += "?" + v.Encode()
-	}
-
-	body, err := s.Request("GET", uri, nil)
+// ServerCategories returns an array of the channel categories of a server.
+// serverID : The ID of a Server.
+func (s *Session) ServerCategories(serverID ServerID) ([]*Category, error) {
+	body, err := s.Request("GET", EndpointServerCategories(serverID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []ForumTopicSummary
+	var st struct {
+		Categories []*Category `json:"categories"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Categories, err
 }
 
-// ChannelForumTopic returns a topic in a channel.
-// channelID : The ID of a Channel.
-// topicID   : The ID of a Topic.
-func (s *Session) ChannelForumTopic(channelID string, topicID int) (*ForumTopic, error) {
-	body, err := s.Request("GET", EndpointChannelTopic(channelID, fmt.Sprintf("%d", topicID)), nil)
+// ServerCategory returns a channel category of a server.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+func (s *Session) ServerCategory(serverID ServerID, categoryID CategoryID) (*Category, error) {
+	body, err := s.Request("GET", EndpointServerCategory(serverID, categoryID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ForumTopic
+	var st struct {
+		Category *Category `json:"category"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Category, err
 }
 
-// ChannelForumTopicUpdate updates a topic in a channel.
-// channelID : The ID of a Channel.
-// topicID   : The ID of a Topic.
-// data	     : The data for the topic.
-func (s *Session) ChannelForumTopicUpdate(channelID string, topicID int, data *ChannelForumTopicUpdate) (*ForumTopic, error) {
-	body, err := s.Request("PATCH", EndpointChannelTopic(channelID, fmt.Sprintf("%d", topicID)), data)
+// ServerCategoryUpdate updates a channel category of a server.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+// data       : The data for the category.
+func (s *Session) ServerCategoryUpdate(serverID ServerID, categoryID CategoryID, data *ServerCategoryUpdate) (*Category, error) {
+	body, err := s.Request("PATCH", EndpointServerCategory(serverID, categoryID), data)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ForumTopic
+	var st struct {
+		Category *Category `json:"category"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.Category, err
 }
 
-// ChannelForumTopicDelete deletes a topic in a channel.
-// channelID : The ID of a Channel.
-// topicID   : The ID of a Topic.
-func (s *Session) ChannelForumTopicDelete(channelID string, topicID int) error {
-	_, err := s.Request("DELETE", EndpointChannelTopic(channelID, fmt.Sprintf("%d", topicID)), nil)
+// ServerCategoryDelete deletes a channel category of a server.
+// Channels of the category are not deleted.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+func (s *Session) ServerCategoryDelete(serverID ServerID, categoryID CategoryID) error {
+	_, err := s.Request("DELETE", EndpointServerCategory(serverID, categoryID), nil)
 	return err
 }
 
-// ChannelForumTopicPin pins a topic in a channel.
-// channelID : The ID of a Channel.
-// topicID   : The ID of a Topic.
-func (s *Session) ChannelForumTopicPin(channelID string, topicID int) error {
-	_, err := s.Request("PUT", EndpointChannelTopicPin(channelID, fmt.Sprintf("%d", topicID)), nil)
-	return err
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Members
+// ------------------------------------------------------------------------------------------------
+
+// ServerMemberGet returns a member of the server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (s *Session) ServerMemberGet(serverID ServerID, userID UserID) (*ServerMember, error) {
+	body, err := s.Request("GET", EndpointServerMember(serverID, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Member *ServerMember `json:"member"`
+	}
+	err = unmarshal(body, &st)
+	return st.Member, err
 }
 
-// ChannelForumTopicUnpin unpins a topic in a channel.
-// channelID : The ID of a Channel.
-// topicID   : The ID of a Topic.
-func (s *Session) ChannelForumTopicUnpin(channelID string, topicID int) error {
-	_, err := s.Request("DELETE", EndpointChannelTopicPin(channelID, fmt.Sprintf("%d", topicID)), nil)
+// ServerMemberKick kicks a member from a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (s *Session) ServerMemberKick(serverID ServerID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointServerMember(serverID, userID), nil)
+	return err
+}
+
+// ServerMembers returns an array of members of a server.
+// serverID : The ID of a Server.
+func (s *Session) ServerMembers(serverID ServerID) ([]*ServerMemberSummary, error) {
+	body, err := s.Request("GET", EndpointServerMembers(serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Members []*ServerMemberSummary `json:"members"`
+	}
+	err = unmarshal(body, &st)
+	return st.Members, err
+}
+
+// ServerMembersIter returns an iterator over the members of a server.
+// The response body is read in full before returning, members are then
+// decoded one at a time as the iterator advances, so only the decoded
+// members kept by the caller add to the memory used by the body.
+// serverID : The ID of a Server.
+func (s *Session) ServerMembersIter(serverID ServerID) (*MemberIterator, error) {
+	body, err := s.Request("GET", EndpointServerMembers(serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMemberIterator(body), nil
+}
+
+// SyncMembers fetches all the members of a server, replaces the members
+// cached in the State with them and returns the differences with the
+// previously cached members.
+// serverID : The ID of a Server.
+func (s *Session) SyncMembers(serverID ServerID) (*MemberSync, error) {
+	if s.State == nil {
+		return nil, ErrNilState
+	}
+
+	it, err := s.ServerMembersIter(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*ServerMemberSummary
+	for it.Next() {
+		members = append(members, it.Member())
+	}
+	if err = it.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.State.syncMembers(serverID, members), nil
+}
+
+// ServerMemberNicknameUpdate updates a member's nickname in a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+// nickname : The nickname to set.
+func (s *Session) ServerMemberNicknameUpdate(serverID ServerID, userID UserID, nickname string) (string, error) {
+	body, err := s.Request("PUT", EndpointServerMemberNickname(serverID, userID), &ServerMemberNicknameUpdate{
+		Nickname: nickname,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var st ServerMemberNicknameUpdate
+	err = unmarshal(body, &st)
+	return st.Nickname, err
+}
+
+// ServerMemberNicknameDelete deletes a member's nickname in a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (s *Session) ServerMemberNicknameDelete(serverID ServerID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointServerMemberNickname(serverID, userID), nil)
 	return err
 }
 
 // ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded ListItem
+// Functions specific to Guilded Member Bans
 // ------------------------------------------------------------------------------------------------
 
-// ChannelListItemCreate creates a list item in a channel.
+// ServerMemberBanCreate creates a ban on a member of a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+// reason   : The reason for the ban.
+func (s *Session) ServerMemberBanCreate(serverID ServerID, userID UserID, reason string) (*ServerMemberBan, error) {
+	body, err := s.Request("POST", EndpointServerBansMember(serverID, userID), &ServerMemberBanCreate{
+		Reason: reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ServerMemberBan
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ServerMemberBan returns a ban on a member of a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (s *Session) ServerMemberBan(serverID ServerID, userID UserID) (*ServerMemberBan, error) {
+	body, err := s.Request("GET", EndpointServerBansMember(serverID, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ServerMemberBan
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ServerMemberBanDelete deletes a ban on a member of a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (s *Session) ServerMemberBanDelete(serverID ServerID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointServerBansMember(serverID, userID), nil)
+	return err
+}
+
+// ServerMemberBans returns an array of bans on a member of a server.
+// serverID : The ID of a Server.
+func (s *Session) ServerMemberBans(serverID ServerID) ([]*ServerMemberBan, error) {
+	body, err := s.Request("GET", EndpointServerBans(serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st []*ServerMemberBan
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Forums
+// ------------------------------------------------------------------------------------------------
+
+// ChannelForumTopicCreate creates a topic in a channel.
 // channelID : The ID of a Channel.
-// data	     : The data for the list item.
-func (s *Session) ChannelListItemCreate(channelID string, data *ChannelListItem) (*ListItem, error) {
-	body, err := s.Request("POST", EndpointChannelItems(channelID), data)
+// title     : The title of the topic.
+// content   : The content of the topic.
+func (s *Session) ChannelForumTopicCreate(channelID ChannelID, title, content string) (*ForumTopic, error) {
+	body, err := s.Request("POST", EndpointChannelTopics(channelID), &ChannelForumTopicCreate{
+		Title:   title,
+		Content: content,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ListItem
+	var st *ForumTopic
 	err = unmarshal(body, &st)
 	return st, err
 }
 
-// ChannelListItems returns an array of list items in a channel without notes content.
+// ChannelForumTopics returns an array of topics in a channel.
 // channelID : The ID of a Channel.
-func (s *Session) ChannelListItems(channelID string) ([]*ListItem, error) {
-	body, err := s.Request("GET", EndpointChannelItems(channelID), nil)
+// before    : The timestamp of the oldest topic to return.
+// limit     : The maximum number of topics to return.
+func (s *Session) ChannelForumTopics(channelID ChannelID, before *time.Time, limit int) ([]ForumTopicSummary, error) {
+	uri := EndpointChannelTopics(channelID)
+	v := url.Values{}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if before != nil {
+		v.Set("before", before.Format(time.RFC3339))
+	}
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	body, err := s.Request("GET", uri, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*ListItem
+	var st []ForumTopicSummary
 	err = unmarshal(body, &st)
 	return st, err
 }
 
-// ChannelListItem returns a list item in a channel.
+// ChannelForumTopic returns a topic in a channel.
 // channelID : The ID of a Channel.
-// itemID    : The ID of a ListItem.
-func (s *Session) ChannelListItem(channelID, itemID string) (*ListItem, error) {
-	body, err := s.Request("GET", EndpointChannelItem(channelID, itemID), nil)
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopic(channelID ChannelID, topicID ForumTopicID) (*ForumTopic, error) {
+	body, err := s.Request("GET", EndpointChannelTopic(channelID, topicID), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ListItem
+	var st *ForumTopic
 	err = unmarshal(body, &st)
 	return st, err
 }
 
-// ChannelListItemUpdate updates a list item in a channel.
+// ChannelForumTopicUpdate updates a topic in a channel.
 // channelID : The ID of a Channel.
-// itemID    : The ID of a ListItem.
-// data	     : The data for the list item.
-func (s *Session) ChannelListItemUpdate(channelID, itemID string, data *ChannelListItem) (*ListItem, error) {
-	body, err := s.Request("PUT", EndpointChannelItem(channelID, itemID), data)
+// topicID   : The ID of a Topic.
+// data	     : The data for the topic.
+func (s *Session) ChannelForumTopicUpdate(channelID ChannelID, topicID ForumTopicID, data *ChannelForumTopicUpdate) (*ForumTopic, error) {
+	body, err := s.Request("PATCH", EndpointChannelTopic(channelID, topicID), data)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ListItem
+	var st *ForumTopic
 	err = unmarshal(body, &st)
 	return st, err
 }
 
-// ChannelListItemDelete deletes a list item in a channel.
+// ChannelForumTopicDelete deletes a topic in a channel.
 // channelID : The ID of a Channel.
-// itemID    : The ID of a ListItem.
-func (s *Session) ChannelListItemDelete(channelID, itemID string) error {
-	_, err := s.Request("DELETE", EndpointChannelItem(channelID, itemID), nil)
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicDelete(channelID ChannelID, topicID ForumTopicID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopic(channelID, topicID), nil)
 	return err
 }
 
-// ChannelListItemComplete completes a list item in a channel.
+// ChannelForumTopicPin pins a topic in a channel.
 // channelID : The ID of a Channel.
-// itemID    : The ID of a ListItem.
-func (s *Session) ChannelListItemComplete(channelID, itemID string) error {
-	_, err := s.Request("POST", EndpointChannelItemComplete(channelID, itemID), nil)
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicPin(channelID ChannelID, topicID ForumTopicID) error {
+	_, err := s.Request("PUT", EndpointChannelTopicPin(channelID, topicID), nil)
+	return err
+}
+
+// ChannelForumTopicUnpin unpins a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicUnpin(channelID ChannelID, topicID ForumTopicID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopicPin(channelID, topicID), nil)
+	return err
+}
+
+// ChannelForumTopicLock locks a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicLock(channelID ChannelID, topicID ForumTopicID) error {
+	_, err := s.Request("PUT", EndpointChannelTopicLock(channelID, topicID), nil)
+	return err
+}
+
+// ChannelForumTopicUnlock unlocks a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicUnlock(channelID ChannelID, topicID ForumTopicID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopicLock(channelID, topicID), nil)
 	return err
 }
 
-// ChannelListItemUncomplete uncompletes a list item in a channel.
-// channelID : The ID of a Channel.
-// itemID    : The ID of a ListItem.
-func (s *Session) ChannelListItemUncomplete(channelID, itemID string) error {
-	_, err := s.Request("DELETE", EndpointChannelItemComplete(channelID, itemID), nil)
-	return err
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Forum Topic Comments
+// ------------------------------------------------------------------------------------------------
+
+// ChannelForumTopicCommentCreate creates a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// content   : The content of the comment.
+func (s *Session) ChannelForumTopicCommentCreate(channelID ChannelID, topicID ForumTopicID, content string) (*ForumTopicComment, error) {
+	body, err := s.Request("POST", EndpointChannelTopicComments(channelID, topicID), &ChannelForumTopicComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		ForumTopicComment *ForumTopicComment `json:"forumTopicComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.ForumTopicComment, err
+}
+
+// ChannelForumTopicComments returns an array of comments on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+func (s *Session) ChannelForumTopicComments(channelID ChannelID, topicID ForumTopicID) ([]*ForumTopicComment, error) {
+	body, err := s.Request("GET", EndpointChannelTopicComments(channelID, topicID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		ForumTopicComments []*ForumTopicComment `json:"forumTopicComments"`
+	}
+	err = unmarshal(body, &st)
+	return st.ForumTopicComments, err
+}
+
+// ChannelForumTopicComment returns a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelForumTopicComment(channelID ChannelID, topicID ForumTopicID, commentID ForumTopicCommentID) (*ForumTopicComment, error) {
+	body, err := s.Request("GET", EndpointChannelTopicComment(channelID, topicID, commentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		ForumTopicComment *ForumTopicComment `json:"forumTopicComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.ForumTopicComment, err
+}
+
+// ChannelForumTopicCommentUpdate updates a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// commentID : The ID of a Comment.
+// content   : The new content of the comment.
+func (s *Session) ChannelForumTopicCommentUpdate(channelID ChannelID, topicID ForumTopicID, commentID ForumTopicCommentID, content string) (*ForumTopicComment, error) {
+	body, err := s.Request("PATCH", EndpointChannelTopicComment(channelID, topicID, commentID), &ChannelForumTopicComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		ForumTopicComment *ForumTopicComment `json:"forumTopicComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.ForumTopicComment, err
+}
+
+// ChannelForumTopicCommentDelete deletes a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelForumTopicCommentDelete(channelID ChannelID, topicID ForumTopicID, commentID ForumTopicCommentID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopicComment(channelID, topicID, commentID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded ListItem
+// ------------------------------------------------------------------------------------------------
+
+// ChannelListItemCreate creates a list item in a channel.
+// channelID : The ID of a Channel.
+// data	     : The data for the list item.
+func (s *Session) ChannelListItemCreate(channelID ChannelID, data *ChannelListItem) (*ListItem, error) {
+	body, err := s.Request("POST", EndpointChannelItems(channelID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ListItem
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelListItems returns an array of list items in a channel without notes content.
+// channelID : The ID of a Channel.
+func (s *Session) ChannelListItems(channelID ChannelID) ([]*ListItem, error) {
+	body, err := s.Request("GET", EndpointChannelItems(channelID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st []*ListItem
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelListItem returns a list item in a channel.
+// channelID : The ID of a Channel.
+// itemID    : The ID of a ListItem.
+func (s *Session) ChannelListItem(channelID ChannelID, itemID ListItemID) (*ListItem, error) {
+	body, err := s.Request("GET", EndpointChannelItem(channelID, itemID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ListItem
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelListItemUpdate updates a list item in a channel.
+// channelID : The ID of a Channel.
+// itemID    : The ID of a ListItem.
+// data	     : The data for the list item.
+func (s *Session) ChannelListItemUpdate(channelID ChannelID, itemID ListItemID, data *ChannelListItem) (*ListItem, error) {
+	body, err := s.Request("PUT", EndpointChannelItem(channelID, itemID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ListItem
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelListItemDelete deletes a list item in a channel.
+// channelID : The ID of a Channel.
+// itemID    : The ID of a ListItem.
+func (s *Session) ChannelListItemDelete(channelID ChannelID, itemID ListItemID) error {
+	_, err := s.Request("DELETE", EndpointChannelItem(channelID, itemID), nil)
+	return err
+}
+
+// ChannelListItemComplete completes a list item in a channel.
+// channelID : The ID of a Channel.
+// itemID    : The ID of a ListItem.
+func (s *Session) ChannelListItemComplete(channelID ChannelID, itemID ListItemID) error {
+	_, err := s.Request("POST", EndpointChannelItemComplete(channelID, itemID), nil)
+	return err
+}
+
+// ChannelListItemUncomplete uncompletes a list item in a channel.
+// channelID : The ID of a Channel.
+// itemID    : The ID of a ListItem.
+func (s *Session) ChannelListItemUncomplete(channelID ChannelID, itemID ListItemID) error {
+	_, err := s.Request("DELETE", EndpointChannelItemComplete(channelID, itemID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Docs
+// ------------------------------------------------------------------------------------------------
+
+// ChannelDocCreate creates a doc in a channel.
+// channelID : The ID of a Channel.
+// data	     : The data for the doc.
+func (s *Session) ChannelDocCreate(channelID ChannelID, data *ChannelDoc) (*Doc, error) {
+	body, err := s.Request("POST", EndpointChannelDocs(channelID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *Doc
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelDoc returns a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+func (s *Session) ChannelDoc(channelID ChannelID, docID DocID) (*Doc, error) {
+	body, err := s.Request("GET", EndpointChannelDoc(channelID, docID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *Doc
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelDocs returns an array of docs in a channel.
+// channelID : The ID of a Channel.
+func (s *Session) ChannelDocs(channelID ChannelID) ([]*Doc, error) {
+	body, err := s.Request("GET", EndpointChannelDocs(channelID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st []*Doc
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelDocUpdate updates a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// data	     : The data for the doc.
+func (s *Session) ChannelDocUpdate(channelID ChannelID, docID DocID, data *ChannelDoc) (*Doc, error) {
+	body, err := s.Request("PUT", EndpointChannelDoc(channelID, docID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *Doc
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ChannelDocDelete deletes a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+func (s *Session) ChannelDocDelete(channelID ChannelID, docID DocID) error {
+	_, err := s.Request("DELETE", EndpointChannelDoc(channelID, docID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Doc Comments
+// ------------------------------------------------------------------------------------------------
+
+// ChannelDocCommentCreate creates a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// content   : The content of the comment.
+func (s *Session) ChannelDocCommentCreate(channelID ChannelID, docID DocID, content string) (*DocComment, error) {
+	body, err := s.Request("POST", EndpointChannelDocComments(channelID, docID), &ChannelDocComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		DocComment *DocComment `json:"docComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.DocComment, err
+}
+
+// ChannelDocComments returns an array of comments on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+func (s *Session) ChannelDocComments(channelID ChannelID, docID DocID) ([]*DocComment, error) {
+	body, err := s.Request("GET", EndpointChannelDocComments(channelID, docID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		DocComments []*DocComment `json:"docComments"`
+	}
+	err = unmarshal(body, &st)
+	return st.DocComments, err
+}
+
+// ChannelDocComment returns a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelDocComment(channelID ChannelID, docID DocID, commentID DocCommentID) (*DocComment, error) {
+	body, err := s.Request("GET", EndpointChannelDocComment(channelID, docID, commentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		DocComment *DocComment `json:"docComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.DocComment, err
+}
+
+// ChannelDocCommentUpdate updates a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// commentID : The ID of a Comment.
+// content   : The new content of the comment.
+func (s *Session) ChannelDocCommentUpdate(channelID ChannelID, docID DocID, commentID DocCommentID, content string) (*DocComment, error) {
+	body, err := s.Request("PATCH", EndpointChannelDocComment(channelID, docID, commentID), &ChannelDocComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		DocComment *DocComment `json:"docComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.DocComment, err
+}
+
+// ChannelDocCommentDelete deletes a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelDocCommentDelete(channelID ChannelID, docID DocID, commentID DocCommentID) error {
+	_, err := s.Request("DELETE", EndpointChannelDocComment(channelID, docID, commentID), nil)
+	return err
+}
+
+// ChannelDocCommentReactionAdd adds a reaction to a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelDocCommentReactionAdd(channelID ChannelID, docID DocID, commentID DocCommentID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelDocCommentReaction(channelID, docID, commentID, emoteID), nil)
+	return err
+}
+
+// ChannelDocCommentReactionDelete deletes a reaction from a comment on a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelDocCommentReactionDelete(channelID ChannelID, docID DocID, commentID DocCommentID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelDocCommentReaction(channelID, docID, commentID, emoteID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Calendars
+// ------------------------------------------------------------------------------------------------
+
+// ChannelEventCreate creates a calendar event in a channel.
+// channelID : The ID of a Channel.
+// data	     : The data for the event.
+func (s *Session) ChannelEventCreate(channelID ChannelID, data *ChannelEvent) (*CalendarEvent, error) {
+	body, err := s.Request("POST", EndpointChannelEvents(channelID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEvent *CalendarEvent `json:"calendarEvent"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEvent, err
+}
+
+// ChannelEvent returns a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+func (s *Session) ChannelEvent(channelID ChannelID, eventID CalendarEventID) (*CalendarEvent, error) {
+	body, err := s.Request("GET", EndpointChannelEvent(channelID, eventID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEvent *CalendarEvent `json:"calendarEvent"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEvent, err
+}
+
+// ChannelEvents returns an array of calendar events in a channel.
+// channelID : The ID of a Channel.
+// before    : Only events starting before this time, if not nil.
+// after     : Only events starting after this time, if not nil.
+// limit     : The maximum number of events, 0 for the default.
+func (s *Session) ChannelEvents(channelID ChannelID, before, after *time.Time, limit int) ([]*CalendarEvent, error) {
+	uri := EndpointChannelEvents(channelID)
+
+	v := url.Values{}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if before != nil {
+		v.Set("before", before.Format(time.RFC3339))
+	}
+	if after != nil {
+		v.Set("after", after.Format(time.RFC3339))
+	}
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+	body, err := s.Request("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEvents []*CalendarEvent `json:"calendarEvents"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEvents, err
+}
+
+// ChannelEventUpdate updates a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// data	     : The data for the event.
+func (s *Session) ChannelEventUpdate(channelID ChannelID, eventID CalendarEventID, data *ChannelEvent) (*CalendarEvent, error) {
+	body, err := s.Request("PATCH", EndpointChannelEvent(channelID, eventID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEvent *CalendarEvent `json:"calendarEvent"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEvent, err
+}
+
+// ChannelEventDelete deletes a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+func (s *Session) ChannelEventDelete(channelID ChannelID, eventID CalendarEventID) error {
+	_, err := s.Request("DELETE", EndpointChannelEvent(channelID, eventID), nil)
+	return err
+}
+
+// ChannelEventRsvp returns a calendar event rsvp in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// userID    : The ID of a User.
+func (s *Session) ChannelEventRsvp(channelID ChannelID, eventID CalendarEventID, userID UserID) (*CalendarEventRsvp, error) {
+	data, err := s.Request("GET", EndpointChannelEventRsvp(channelID, eventID, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *CalendarEventRsvp
+	err = unmarshal(data, &st)
+	return st, err
+}
+
+// ChannelEventRsvpSet create or update a calendar event rsvp in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// userID    : The ID of a User.
+// status    : The status of the rsvp.
+func (s *Session) ChannelEventRsvpSet(channelID ChannelID, eventID CalendarEventID, userID UserID, status RsvpStatus) (*CalendarEventRsvp, error) {
+	data, err := s.Request("PUT", EndpointChannelEventRsvp(channelID, eventID, userID), &CalendarSetRsvpStatusRrequest{Status: status})
+	if err != nil {
+		return nil, err
+	}
+
+	var st *CalendarEventRsvp
+	err = unmarshal(data, &st)
+	return st, err
+}
+
+// ChannelEventRsvpDelete deletes a calendar event rsvp in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// userID    : The ID of a User.
+func (s *Session) ChannelEventRsvpDelete(channelID ChannelID, eventID CalendarEventID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointChannelEventRsvp(channelID, eventID, userID), nil)
+	return err
+}
+
+// ChannelEventRsvps returns an array of calendar event rsvps in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+func (s *Session) ChannelEventRsvps(channelID ChannelID, eventID CalendarEventID) ([]*CalendarEventRsvp, error) {
+	data, err := s.Request("GET", EndpointChannelEventRsvps(channelID, eventID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEventRsvps []*CalendarEventRsvp `json:"calendarEventRsvps"`
+	}
+	err = unmarshal(data, &st)
+	return st.CalendarEventRsvps, err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Calendar Event Series
+// ------------------------------------------------------------------------------------------------
+
+// ChannelEventSeriesUpdate updates the events of a calendar event series in a channel.
+// Set data.CalendarEventID to only update that event and the following ones.
+// channelID : The ID of a Channel.
+// seriesID  : The ID of a CalendarEventSeries.
+// data      : The data for the events.
+func (s *Session) ChannelEventSeriesUpdate(channelID ChannelID, seriesID CalendarEventSeriesID, data *ChannelEventSeriesUpdate) error {
+	_, err := s.Request("PATCH", EndpointChannelEventSeries(channelID, seriesID), data)
+	return err
+}
+
+// ChannelEventSeriesDelete deletes the events of a calendar event series in a channel.
+// channelID : The ID of a Channel.
+// seriesID  : The ID of a CalendarEventSeries.
+// eventID   : The ID of the first CalendarEvent to delete, 0 deletes the whole series.
+func (s *Session) ChannelEventSeriesDelete(channelID ChannelID, seriesID CalendarEventSeriesID, eventID CalendarEventID) error {
+	var data interface{}
+	if eventID != 0 {
+		data = &ChannelEventSeriesDelete{CalendarEventID: eventID}
+	}
+
+	_, err := s.Request("DELETE", EndpointChannelEventSeries(channelID, seriesID), data)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Calendar Event Comments
+// ------------------------------------------------------------------------------------------------
+
+// ChannelEventCommentCreate creates a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// content   : The content of the comment.
+func (s *Session) ChannelEventCommentCreate(channelID ChannelID, eventID CalendarEventID, content string) (*CalendarEventComment, error) {
+	body, err := s.Request("POST", EndpointChannelEventComments(channelID, eventID), &ChannelEventComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEventComment *CalendarEventComment `json:"calendarEventComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEventComment, err
+}
+
+// ChannelEventComments returns an array of comments on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+func (s *Session) ChannelEventComments(channelID ChannelID, eventID CalendarEventID) ([]*CalendarEventComment, error) {
+	body, err := s.Request("GET", EndpointChannelEventComments(channelID, eventID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEventComments []*CalendarEventComment `json:"calendarEventComments"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEventComments, err
+}
+
+// ChannelEventComment returns a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelEventComment(channelID ChannelID, eventID CalendarEventID, commentID CalendarEventCommentID) (*CalendarEventComment, error) {
+	body, err := s.Request("GET", EndpointChannelEventComment(channelID, eventID, commentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEventComment *CalendarEventComment `json:"calendarEventComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEventComment, err
+}
+
+// ChannelEventCommentUpdate updates a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// commentID : The ID of a Comment.
+// content   : The new content of the comment.
+func (s *Session) ChannelEventCommentUpdate(channelID ChannelID, eventID CalendarEventID, commentID CalendarEventCommentID, content string) (*CalendarEventComment, error) {
+	body, err := s.Request("PATCH", EndpointChannelEventComment(channelID, eventID, commentID), &ChannelEventComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		CalendarEventComment *CalendarEventComment `json:"calendarEventComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.CalendarEventComment, err
+}
+
+// ChannelEventCommentDelete deletes a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// commentID : The ID of a Comment.
+func (s *Session) ChannelEventCommentDelete(channelID ChannelID, eventID CalendarEventID, commentID CalendarEventCommentID) error {
+	_, err := s.Request("DELETE", EndpointChannelEventComment(channelID, eventID, commentID), nil)
+	return err
+}
+
+// ChannelEventCommentReactionAdd adds a reaction to a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelEventCommentReactionAdd(channelID ChannelID, eventID CalendarEventID, commentID CalendarEventCommentID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelEventCommentReaction(channelID, eventID, commentID, emoteID), nil)
+	return err
+}
+
+// ChannelEventCommentReactionDelete deletes a reaction from a comment on a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelEventCommentReactionDelete(channelID ChannelID, eventID CalendarEventID, commentID CalendarEventCommentID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelEventCommentReaction(channelID, eventID, commentID, emoteID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Announcements
+// ------------------------------------------------------------------------------------------------
+
+// ChannelAnnouncementCreate creates an announcement in a channel.
+// channelID : The ID of a Channel.
+// data      : The data for the announcement.
+func (s *Session) ChannelAnnouncementCreate(channelID ChannelID, data *ChannelAnnouncementCreate) (*Announcement, error) {
+	body, err := s.Request("POST", EndpointChannelAnnouncements(channelID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Announcement *Announcement `json:"announcement"`
+	}
+	err = unmarshal(body, &st)
+	return st.Announcement, err
+}
+
+// ChannelAnnouncements returns an array of announcements in a channel.
+// channelID : The ID of a Channel.
+// before    : The timestamp of the oldest announcement to return.
+// limit     : The maximum number of announcements to return.
+func (s *Session) ChannelAnnouncements(channelID ChannelID, before *time.Time, limit int) ([]*Announcement, error) {
+	uri := EndpointChannelAnnouncements(channelID)
+	v := url.Values{}
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	if before != nil {
+		v.Set("before", before.Format(time.RFC3339))
+	}
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	body, err := s.Request("GET", uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Announcements []*Announcement `json:"announcements"`
+	}
+	err = unmarshal(body, &st)
+	return st.Announcements, err
+}
+
+// ChannelAnnouncement returns an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+func (s *Session) ChannelAnnouncement(channelID ChannelID, announcementID AnnouncementID) (*Announcement, error) {
+	body, err := s.Request("GET", EndpointChannelAnnouncement(channelID, announcementID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Announcement *Announcement `json:"announcement"`
+	}
+	err = unmarshal(body, &st)
+	return st.Announcement, err
+}
+
+// ChannelAnnouncementUpdate updates an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// data           : The data for the announcement.
+func (s *Session) ChannelAnnouncementUpdate(channelID ChannelID, announcementID AnnouncementID, data *ChannelAnnouncementUpdate) (*Announcement, error) {
+	body, err := s.Request("PATCH", EndpointChannelAnnouncement(channelID, announcementID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Announcement *Announcement `json:"announcement"`
+	}
+	err = unmarshal(body, &st)
+	return st.Announcement, err
+}
+
+// ChannelAnnouncementDelete deletes an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+func (s *Session) ChannelAnnouncementDelete(channelID ChannelID, announcementID AnnouncementID) error {
+	_, err := s.Request("DELETE", EndpointChannelAnnouncement(channelID, announcementID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Announcement Comments
+// ------------------------------------------------------------------------------------------------
+
+// ChannelAnnouncementCommentCreate creates a comment on an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// content        : The content of the comment.
+func (s *Session) ChannelAnnouncementCommentCreate(channelID ChannelID, announcementID AnnouncementID, content string) (*AnnouncementComment, error) {
+	body, err := s.Request("POST", EndpointChannelAnnouncementComments(channelID, announcementID), &ChannelAnnouncementComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		AnnouncementComment *AnnouncementComment `json:"announcementComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.AnnouncementComment, err
+}
+
+// ChannelAnnouncementComments returns an array of comments on an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+func (s *Session) ChannelAnnouncementComments(channelID ChannelID, announcementID AnnouncementID) ([]*AnnouncementComment, error) {
+	body, err := s.Request("GET", EndpointChannelAnnouncementComments(channelID, announcementID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		AnnouncementComments []*AnnouncementComment `json:"announcementComments"`
+	}
+	err = unmarshal(body, &st)
+	return st.AnnouncementComments, err
+}
+
+// ChannelAnnouncementComment returns a comment on an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// commentID      : The ID of a Comment.
+func (s *Session) ChannelAnnouncementComment(channelID ChannelID, announcementID AnnouncementID, commentID AnnouncementCommentID) (*AnnouncementComment, error) {
+	body, err := s.Request("GET", EndpointChannelAnnouncementComment(channelID, announcementID, commentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		AnnouncementComment *AnnouncementComment `json:"announcementComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.AnnouncementComment, err
+}
+
+// ChannelAnnouncementCommentUpdate updates a comment on an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// commentID      : The ID of a Comment.
+// content        : The new content of the comment.
+func (s *Session) ChannelAnnouncementCommentUpdate(channelID ChannelID, announcementID AnnouncementID, commentID AnnouncementCommentID, content string) (*AnnouncementComment, error) {
+	body, err := s.Request("PATCH", EndpointChannelAnnouncementComment(channelID, announcementID, commentID), &ChannelAnnouncementComment{
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		AnnouncementComment *AnnouncementComment `json:"announcementComment"`
+	}
+	err = unmarshal(body, &st)
+	return st.AnnouncementComment, err
+}
+
+// ChannelAnnouncementCommentDelete deletes a comment on an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// commentID      : The ID of a Comment.
+func (s *Session) ChannelAnnouncementCommentDelete(channelID ChannelID, announcementID AnnouncementID, commentID AnnouncementCommentID) error {
+	_, err := s.Request("DELETE", EndpointChannelAnnouncementComment(channelID, announcementID, commentID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Reactions
+// ------------------------------------------------------------------------------------------------
+
+// Guilded's API has no endpoint listing the reactions to a content, they are
+// only received through the reaction events. Deleting reactions in bulk is
+// only supported on chat messages, see ChannelMessageReactionsDelete.
+
+// ChannelContentReactionAdd adds a reaction to a content in a channel.
+// channelID : The ID of a Channel.
+// contentID : The ID of a Content.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelContentReactionAdd(channelID ChannelID, contentID string, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelReaction(channelID, contentID, emoteID), nil)
+	return err
+}
+
+// ChannelContentReactionDelete deletes a reaction to a content in a channel.
+// channelID : The ID of a Channel.
+// contentID : The ID of a Content.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelContentReactionDelete(channelID ChannelID, contentID string, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelReaction(channelID, contentID, emoteID), nil)
+	return err
+}
+
+// ChannelMessageReactionAdd adds a reaction to a message in a channel.
+// channelID : The ID of a Channel.
+// messageID : The ID of a Message.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelMessageReactionAdd(channelID ChannelID, messageID MessageID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelMessageReaction(channelID, messageID, emoteID), nil)
+	return err
+}
+
+// ChannelMessageReactionDelete deletes a reaction from a message in a channel.
+// channelID : The ID of a Channel.
+// messageID : The ID of a Message.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelMessageReactionDelete(channelID ChannelID, messageID MessageID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelMessageReaction(channelID, messageID, emoteID), nil)
+	return err
+}
+
+// ChannelMessageReactionsDelete deletes the reactions from a message in a channel.
+// Chat messages are the only content Guilded can delete reactions from in bulk.
+// channelID : The ID of a Channel.
+// messageID : The ID of a Message.
+// emoteID   : The ID of an Emote to delete the reactions of, 0 deletes every reaction.
+func (s *Session) ChannelMessageReactionsDelete(channelID ChannelID, messageID MessageID, emoteID EmoteID) error {
+	uri := EndpointChannelMessageReactions(channelID, messageID)
+	if emoteID != 0 {
+		uri += "?" + url.Values{"emoteId": {emoteID.String()}}.Encode()
+	}
+
+	_, err := s.Request("DELETE", uri, nil)
+	return err
+}
+
+// ChannelForumTopicReactionAdd adds a reaction to a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelForumTopicReactionAdd(channelID ChannelID, topicID ForumTopicID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelTopicReaction(channelID, topicID, emoteID), nil)
+	return err
+}
+
+// ChannelForumTopicReactionDelete deletes a reaction from a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelForumTopicReactionDelete(channelID ChannelID, topicID ForumTopicID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopicReaction(channelID, topicID, emoteID), nil)
+	return err
+}
+
+// ChannelForumTopicCommentReactionAdd adds a reaction to a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelForumTopicCommentReactionAdd(channelID ChannelID, topicID ForumTopicID, commentID ForumTopicCommentID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelTopicCommentReaction(channelID, topicID, commentID, emoteID), nil)
+	return err
+}
+
+// ChannelForumTopicCommentReactionDelete deletes a reaction from a comment on a topic in a channel.
+// channelID : The ID of a Channel.
+// topicID   : The ID of a Topic.
+// commentID : The ID of a Comment.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelForumTopicCommentReactionDelete(channelID ChannelID, topicID ForumTopicID, commentID ForumTopicCommentID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelTopicCommentReaction(channelID, topicID, commentID, emoteID), nil)
+	return err
+}
+
+// ChannelDocReactionAdd adds a reaction to a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelDocReactionAdd(channelID ChannelID, docID DocID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelDocReaction(channelID, docID, emoteID), nil)
+	return err
+}
+
+// ChannelDocReactionDelete deletes a reaction from a doc in a channel.
+// channelID : The ID of a Channel.
+// docID     : The ID of a Doc.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelDocReactionDelete(channelID ChannelID, docID DocID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelDocReaction(channelID, docID, emoteID), nil)
+	return err
+}
+
+// ChannelEventReactionAdd adds a reaction to a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelEventReactionAdd(channelID ChannelID, eventID CalendarEventID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelEventReaction(channelID, eventID, emoteID), nil)
+	return err
+}
+
+// ChannelEventReactionDelete deletes a reaction from a calendar event in a channel.
+// channelID : The ID of a Channel.
+// eventID   : The ID of an CalendarEvent.
+// emoteID   : The ID of an Emote.
+func (s *Session) ChannelEventReactionDelete(channelID ChannelID, eventID CalendarEventID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelEventReaction(channelID, eventID, emoteID), nil)
+	return err
+}
+
+// ChannelAnnouncementReactionAdd adds a reaction to an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// emoteID        : The ID of an Emote.
+func (s *Session) ChannelAnnouncementReactionAdd(channelID ChannelID, announcementID AnnouncementID, emoteID EmoteID) error {
+	_, err := s.Request("PUT", EndpointChannelAnnouncementReaction(channelID, announcementID, emoteID), nil)
+	return err
+}
+
+// ChannelAnnouncementReactionDelete deletes a reaction from an announcement in a channel.
+// channelID      : The ID of a Channel.
+// announcementID : The ID of an Announcement.
+// emoteID        : The ID of an Emote.
+func (s *Session) ChannelAnnouncementReactionDelete(channelID ChannelID, announcementID AnnouncementID, emoteID EmoteID) error {
+	_, err := s.Request("DELETE", EndpointChannelAnnouncementReaction(channelID, announcementID, emoteID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded XP
+// ------------------------------------------------------------------------------------------------
+
+// ServerMemberXPAward awards XP to a member of a server.
+// It returns the new XP total of the member.
+// serverID : The ID of a Server.
+// memberID : The ID of a Member.
+// amount   : The amount of XP to award.
+func (s *Session) ServerMemberXPAward(serverID ServerID, memberID UserID, amount int) (int, error) {
+	body, err := s.Request("POST", EndpointServerXPMember(serverID, memberID), &ServerXPUpdate{Amount: amount})
+	if err != nil {
+		return 0, err
+	}
+
+	var st struct {
+		Total int `json:"total"`
+	}
+	err = unmarshal(body, &st)
+	return st.Total, err
+}
+
+// ServerMemberXPSet sets role XP to a role of a server.
+// serverID : The ID of a Server.
+// memberID   : The ID of a Member.
+// total   : The amount of XP set.
+func (s *Session) ServerMemberXPSet(serverID ServerID, memberID UserID, total int) (int, error) {
+	body, err := s.Request("PUT", EndpointServerXPMember(serverID, memberID), &ServerXPSet{Total: total})
+	if err != nil {
+		return 0, err
+	}
+
+	var st struct {
+		Total int `json:"total"`
+	}
+
+	err = unmarshal(body, &st)
+	return st.Total, err
+}
+
+// ServerRoleXPAward awards XP to a role of a server.
+// serverID : The ID of a Server.
+// roleID   : The ID of a Role.
+// amount   : The amount of XP to award.
+func (s *Session) ServerRoleXPAward(serverID ServerID, roleID RoleID, amount int) (int, error) {
+	body, err := s.Request("POST", EndpointServerXPRoles(serverID, roleID), &ServerXPUpdate{Amount: amount})
+	if err != nil {
+		return 0, err
+	}
+
+	var st struct {
+		Amount int `json:"amount"`
+	}
+	err = unmarshal(body, &st)
+	return st.Amount, err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Social Links
+// ------------------------------------------------------------------------------------------------
+
+// ServerMemberSocialLink returns a social link of a member of a server.
+// serverID : The ID of a Server.
+// memberID : The ID of a Member.
+// linkType : The type of the social-link.
+func (s *Session) ServerMemberSocialLink(serverID ServerID, memberID UserID, linkType string) (*ServerSocialLink, error) {
+	body, err := s.Request("GET", EndpointServerMemberSocialLink(serverID, memberID, linkType), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st *ServerSocialLink
+	err = unmarshal(body, &st)
+	return st, err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Group Membership
+// ------------------------------------------------------------------------------------------------
+
+// GroupMemberAdd adds a member to a group.
+// groupID : The ID of a Group.
+// memberID : The ID of a Member.
+func (s *Session) GroupMemberAdd(groupID GroupID, userID UserID) error {
+	_, err := s.Request("PUT", EndpointGroupMember(groupID, userID), nil)
+	return err
+}
+
+// GroupMemberRemove removes a member from a group.
+// groupID : The ID of a Group.
+// memberID : The ID of a Member.
+func (s *Session) GroupMemberRemove(groupID GroupID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointGroupMember(groupID, userID), nil)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Roles
+// ------------------------------------------------------------------------------------------------
+
+// ServerRoleCreate creates a role in a server.
+// serverID : The ID of a Server.
+// data     : The data for the role.
+func (s *Session) ServerRoleCreate(serverID ServerID, data *ServerRoleCreate) (*Role, error) {
+	body, err := s.Request("POST", EndpointServerRoles(serverID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Role *Role `json:"role"`
+	}
+	err = unmarshal(body, &st)
+	return st.Role, err
+}
+
+// ServerRoles returns an array of roles of a server.
+// serverID : The ID of a Server.
+func (s *Session) ServerRoles(serverID ServerID) ([]*Role, error) {
+	body, err := s.Request("GET", EndpointServerRoles(serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Roles []*Role `json:"roles"`
+	}
+	err = unmarshal(body, &st)
+	return st.Roles, err
+}
+
+// ServerRole returns a role of a server.
+// serverID : The ID of a Server.
+// roleID   : The ID of a Role.
+func (s *Session) ServerRole(serverID ServerID, roleID RoleID) (*Role, error) {
+	body, err := s.Request("GET", EndpointServerRole(serverID, roleID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Role *Role `json:"role"`
+	}
+	err = unmarshal(body, &st)
+	return st.Role, err
+}
+
+// ServerRoleUpdate updates a role of a server.
+// serverID : The ID of a Server.
+// roleID   : The ID of a Role.
+// data     : The data for the role.
+func (s *Session) ServerRoleUpdate(serverID ServerID, roleID RoleID, data *ServerRoleUpdate) (*Role, error) {
+	body, err := s.Request("PATCH", EndpointServerRole(serverID, roleID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		Role *Role `json:"role"`
+	}
+	err = unmarshal(body, &st)
+	return st.Role, err
+}
+
+// ServerRoleDelete deletes a role of a server.
+// serverID : The ID of a Server.
+// roleID   : The ID of a Role.
+func (s *Session) ServerRoleDelete(serverID ServerID, roleID RoleID) error {
+	_, err := s.Request("DELETE", EndpointServerRole(serverID, roleID), nil)
+	return err
+}
+
+// ServerMemberRolesResolve returns the roles of a member of a server,
+// ordered by descending position.
+// serverID : The ID of a Server.
+// member   : The member to resolve the RoleIds of.
+func (s *Session) ServerMemberRolesResolve(serverID ServerID, member *ServerMember) ([]*Role, error) {
+	roles, err := s.ServerRoles(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return member.Roles(roles), nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Functions specific to Guilded Role Membership
+// ------------------------------------------------------------------------------------------------
+
+// ServerMemberRoleAdd adds a role to a member of a server.
+// serverID : The ID of a Server.
+// memberID : The ID of a Member.
+// roleID   : The ID of a Role.
+func (s *Session) ServerMemberRoleAdd(serverID ServerID, memberID UserID, roleID RoleID) error {
+	_, err := s.Request("PUT", EndpointServerMemberRole(serverID, memberID, roleID), nil)
+	return err
+}
+
+// ServerMemberRoleRemove removes a role from a member of a server.
+// serverID : The ID of a Server.
+// memberID : The ID of a Member.
+// roleID   : The ID of a Role.
+func (s *Session) ServerMemberRoleRemove(serverID ServerID, memberID UserID, roleID RoleID) error {
+	_, err := s.Request("DELETE", EndpointServerMemberRole(serverID, memberID, roleID), nil)
+	return err
+}
+
+// ServerMemberRoles returns a list of roles of a member of a server.
+// serverID : The ID of a Server.
+// memberID : The ID of a Member.
+func (s *Session) ServerMemberRoles(serverID ServerID, memberID UserID) ([]RoleID, error) {
+	body, err := s.Request("GET", EndpointServerMemberRoles(serverID, memberID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var st struct {
+		RoleIDs []RoleID `json:"roleIds"`
+	}
+	err = unmarshal(body, &st)
+	return st.RoleIDs, err
 }
 
 // ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Docs
+// Functions specific to Guilded Permission Overrides
 // ------------------------------------------------------------------------------------------------
 
-// ChannelDocCreate creates a doc in a channel.
-// channelID : The ID of a Channel.
-// data	     : The data for the doc.
-func (s *Session) ChannelDocCreate(channelID string, data *ChannelDoc) (*Doc, error) {
-	body, err := s.Request("POST", EndpointChannelDocs(channelID), data)
+// ChannelRolePermissionCreate creates a role permission override of a channel.
+// serverID    : The ID of a Server.
+// channelID   : The ID of a Channel.
+// roleID      : The ID of a Role.
+// permissions : The permissions to allow or deny.
+func (s *Session) ChannelRolePermissionCreate(serverID ServerID, channelID ChannelID, roleID RoleID, permissions PermissionOverrides) (*ChannelRolePermission, error) {
+	body, err := s.Request("POST", EndpointServerChannelPermission(serverID, channelID, "roles", roleID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *Doc
+	var st struct {
+		ChannelRolePermission *ChannelRolePermission `json:"channelRolePermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelRolePermission, err
 }
 
-// ChannelDoc returns a doc in a channel.
+// ChannelRolePermission returns a role permission override of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-// docID     : The ID of a Doc.
-func (s *Session) ChannelDoc(channelID string, docID int) (*Doc, error) {
-	body, err := s.Request("GET", EndpointChannelDoc(channelID, fmt.Sprintf("%d", docID)), nil)
+// roleID    : The ID of a Role.
+func (s *Session) ChannelRolePermission(serverID ServerID, channelID ChannelID, roleID RoleID) (*ChannelRolePermission, error) {
+	body, err := s.Request("GET", EndpointServerChannelPermission(serverID, channelID, "roles", roleID.String()), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *Doc
+	var st struct {
+		ChannelRolePermission *ChannelRolePermission `json:"channelRolePermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelRolePermission, err
 }
 
-// ChannelDocs returns an array of docs in a channel.
+// ChannelRolePermissions returns an array of the role permission overrides of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-func (s *Session) ChannelDocs(channelID string) ([]*Doc, error) {
-	body, err := s.Request("GET", EndpointChannelDocs(channelID), nil)
+func (s *Session) ChannelRolePermissions(serverID ServerID, channelID ChannelID) ([]*ChannelRolePermission, error) {
+	body, err := s.Request("GET", EndpointServerChannelPermissions(serverID, channelID, "roles"), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*Doc
+	var st struct {
+		ChannelRolePermissions []*ChannelRolePermission `json:"channelRolePermissions"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelRolePermissions, err
 }
 
-// ChannelDocUpdate updates a doc in a channel.
-// channelID : The ID of a Channel.
-// docID     : The ID of a Doc.
-// data	     : The data for the doc.
-func (s *Session) ChannelDocUpdate(channelID string, docID int, data *ChannelDoc) (*Doc, error) {
-	body, err := s.Request("PUT", EndpointChannelDoc(channelID, fmt.Sprintf("%d", docID)), data)
+// ChannelRolePermissionUpdate updates a role permission override of a channel.
+// serverID    : The ID of a Server.
+// channelID   : The ID of a Channel.
+// roleID      : The ID of a Role.
+// permissions : The permissions to allow or deny.
+func (s *Session) ChannelRolePermissionUpdate(serverID ServerID, channelID ChannelID, roleID RoleID, permissions PermissionOverrides) (*ChannelRolePermission, error) {
+	body, err := s.Request("PATCH", EndpointServerChannelPermission(serverID, channelID, "roles", roleID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *Doc
+	var st struct {
+		ChannelRolePermission *ChannelRolePermission `json:"channelRolePermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelRolePermission, err
 }
 
-// ChannelDocDelete deletes a doc in a channel.
+// ChannelRolePermissionDelete deletes a role permission override of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-// docID     : The ID of a Doc.
-func (s *Session) ChannelDocDelete(channelID string, docID int) error {
-	_, err := s.Request("DELETE", EndpointChannelDoc(channelID, fmt.Sprintf("%d", docID)), nil)
+// roleID    : The ID of a Role.
+func (s *Session) ChannelRolePermissionDelete(serverID ServerID, channelID ChannelID, roleID RoleID) error {
+	_, err := s.Request("DELETE", EndpointServerChannelPermission(serverID, channelID, "roles", roleID.String()), nil)
 	return err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Calendars
-// ------------------------------------------------------------------------------------------------
-
-// ChannelEventCreate creates a calendar event in a channel.
-// channelID : The ID of a Channel.
-// data	     : The data for the event.
-func (s *Session) ChannelEventCreate(channelID string, data *ChannelEvent) (*CalendarEvent, error) {
-	body, err := s.Request("POST", EndpointChannelEvents(channelID), data)
+// ChannelUserPermissionCreate creates a user permission override of a channel.
+// serverID    : The ID of a Server.
+// channelID   : The ID of a Channel.
+// userID      : The ID of a User.
+// permissions : The permissions to allow or deny.
+func (s *Session) ChannelUserPermissionCreate(serverID ServerID, channelID ChannelID, userID UserID, permissions PermissionOverrides) (*ChannelUserPermission, error) {
+	body, err := s.Request("POST", EndpointServerChannelPermission(serverID, channelID, "users", userID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *CalendarEvent
+	var st struct {
+		ChannelUserPermission *ChannelUserPermission `json:"channelUserPermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelUserPermission, err
 }
 
-// ChannelEvent returns a calendar event in a channel.
+// ChannelUserPermission returns a user permission override of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-func (s *Session) ChannelEvent(channelID string, eventID int) (*CalendarEvent, error) {
-	body, err := s.Request("GET", EndpointChannelEvent(channelID, fmt.Sprintf("%d", eventID)), nil)
+// userID    : The ID of a User.
+func (s *Session) ChannelUserPermission(serverID ServerID, channelID ChannelID, userID UserID) (*ChannelUserPermission, error) {
+	body, err := s.Request("GET", EndpointServerChannelPermission(serverID, channelID, "users", userID.String()), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *CalendarEvent
+	var st struct {
+		ChannelUserPermission *ChannelUserPermission `json:"channelUserPermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelUserPermission, err
 }
 
-// ChannelEvents returns an array of calendar events in a channel.
+// ChannelUserPermissions returns an array of the user permission overrides of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-// data	     : The data for the event.
-func (s *Session) ChannelEvents(channelID string, before, after *time.Time, limit int) ([]*CalendarEvent, error) {
-	uri := EndpointChannelEvents(channelID)
-
-	v := url.Values{}
-	if limit > 0 {
-		v.Set("limit", strconv.Itoa(limit))
-	}
-	if before != nil {
-		v.Set("before", before.Format(time.RFC3339))
-	}
-	if after != nil {
-		v.Set("after", after.Format(time.RFC3339))
-	}
-	if len(v) > 0 {
-		uri += "?" + v.Encode()
-	}
-	body, err := s.Request("GET", EndpointChannelEvents(channelID), nil)
+func (s *Session) ChannelUserPermissions(serverID ServerID, channelID ChannelID) ([]*ChannelUserPermission, error) {
+	body, err := s.Request("GET", EndpointServerChannelPermissions(serverID, channelID, "users"), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*CalendarEvent
+	var st struct {
+		ChannelUserPermissions []*ChannelUserPermission `json:"channelUserPermissions"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelUserPermissions, err
 }
 
-// ChannelEventUpdate updates a calendar event in a channel.
-// channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-// data	     : The data for the event.
-func (s *Session) ChannelEventUpdate(channelID string, eventID int, data *ChannelEvent) (*CalendarEvent, error) {
-	body, err := s.Request("PATCH", EndpointChannelEvent(channelID, fmt.Sprintf("%d", eventID)), data)
+// ChannelUserPermissionUpdate updates a user permission override of a channel.
+// serverID    : The ID of a Server.
+// channelID   : The ID of a Channel.
+// userID      : The ID of a User.
+// permissions : The permissions to allow or deny.
+func (s *Session) ChannelUserPermissionUpdate(serverID ServerID, channelID ChannelID, userID UserID, permissions PermissionOverrides) (*ChannelUserPermission, error) {
+	body, err := s.Request("PATCH", EndpointServerChannelPermission(serverID, channelID, "users", userID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *CalendarEvent
+	var st struct {
+		ChannelUserPermission *ChannelUserPermission `json:"channelUserPermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.ChannelUserPermission, err
 }
 
-// ChannelEventDelete deletes a calendar event in a channel.
+// ChannelUserPermissionDelete deletes a user permission override of a channel.
+// serverID  : The ID of a Server.
 // channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-func (s *Session) ChannelEventDelete(channelID string, eventID int) error {
-	_, err := s.Request("DELETE", EndpointChannelEvent(channelID, fmt.Sprintf("%d", eventID)), nil)
+// userID    : The ID of a User.
+func (s *Session) ChannelUserPermissionDelete(serverID ServerID, channelID ChannelID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointServerChannelPermission(serverID, channelID, "users", userID.String()), nil)
 	return err
 }
 
-// ChannelEventRsvp returns a calendar event rsvp in a channel.
-// channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-// userID    : The ID of a User.
-func (s *Session) ChannelEventRsvp(channelID string, eventID int, userID string) (*CalendarEventRsvp, error) {
-	data, err := s.Request("GET", EndpointChannelEventRsvp(channelID, fmt.Sprintf("%d", eventID), userID), nil)
+// CategoryRolePermissionCreate creates a role permission override of a category.
+// serverID    : The ID of a Server.
+// categoryID  : The ID of a Category.
+// roleID      : The ID of a Role.
+// permissions : The permissions to allow or deny.
+func (s *Session) CategoryRolePermissionCreate(serverID ServerID, categoryID CategoryID, roleID RoleID, permissions PermissionOverrides) (*CategoryRolePermission, error) {
+	body, err := s.Request("POST", EndpointServerCategoryPermission(serverID, categoryID, "roles", roleID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *CalendarEventRsvp
-	err = unmarshal(data, &st)
-	return st, err
+	var st struct {
+		CategoryRolePermission *CategoryRolePermission `json:"categoryRolePermission"`
+	}
+	err = unmarshal(body, &st)
+	return st.CategoryRolePermission, err
 }
 
-// ChannelEventRsvpSet create or update a calendar event rsvp in a channel.
-// channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-// userID    : The ID of a User.
-// status    : The status of the rsvp.
-func (s *Session) ChannelEventRsvpSet(channelID string, eventID int, userID string, status RsvpStatus) (*CalendarEventRsvp, error) {
-	data, err := s.Request("PUT", EndpointChannelEventRsvp(channelID, fmt.Sprintf("%d", eventID), userID), &CalendarSetRsvpStatusRrequest{Status: status})
+// CategoryRolePermission returns a role permission override of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+// roleID     : The ID of a Role.
+func (s *Session) CategoryRolePermission(serverID ServerID, categoryID CategoryID, roleID RoleID) (*CategoryRolePermission, error) {
+	body, err := s.Request("GET", EndpointServerCategoryPermission(serverID, categoryID, "roles", roleID.String()), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st *CalendarEventRsvp
-	err = unmarshal(data, &st)
-	return st, err
-}
-
-// ChannelEventRsvpDelete deletes a calendar event rsvp in a channel.
-// channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-// userID    : The ID of a User.
-func (s *Session) ChannelEventRsvpDelete(channelID string, eventID int, userID string) error {
-	_, err := s.Request("DELETE", EndpointChannelEventRsvp(channelID, fmt.Sprintf("%d", eventID), userID), nil)
-	return err
+	var st struct {
+		CategoryRolePermission *CategoryRolePermission `json:"categoryRolePermission"`
+	}
+	err = unmarshal(body, &st)
+	return st.CategoryRolePermission, err
 }
 
-// ChannelEventRsvps returns an array of calendar event rsvps in a channel.
-// channelID : The ID of a Channel.
-// eventID   : The ID of an CalendarEvent.
-func (s *Session) ChannelEventRsvps(channelID string, eventID int) ([]*CalendarEventRsvp, error) {
-	data, err := s.Request("GET", EndpointChannelEventRsvps(channelID, fmt.Sprintf("%d", eventID)), nil)
+// CategoryRolePermissions returns an array of the role permission overrides of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+func (s *Session) CategoryRolePermissions(serverID ServerID, categoryID CategoryID) ([]*CategoryRolePermission, error) {
+	body, err := s.Request("GET", EndpointServerCategoryPermissions(serverID, categoryID, "roles"), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var st []*CalendarEventRsvp
-	err = unmarshal(data, &st)
-	return st, err
+	var st struct {
+		CategoryRolePermissions []*CategoryRolePermission `json:"categoryRolePermissions"`
+	}
+	err = unmarshal(body, &st)
+	return st.CategoryRolePermissions, err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Reactions
-// ------------------------------------------------------------------------------------------------
+// CategoryRolePermissionUpdate updates a role permission override of a category.
+// serverID    : The ID of a Server.
+// categoryID  : The ID of a Category.
+// roleID      : The ID of a Role.
+// permissions : The permissions to allow or deny.
+func (s *Session) CategoryRolePermissionUpdate(serverID ServerID, categoryID CategoryID, roleID RoleID, permissions PermissionOverrides) (*CategoryRolePermission, error) {
+	body, err := s.Request("PATCH", EndpointServerCategoryPermission(serverID, categoryID, "roles", roleID.String()), &PermissionOverridesUpdate{Permissions: permissions})
+	if err != nil {
+		return nil, err
+	}
 
-// ChannelContentReactionAdd adds a reaction to a content in a channel.
-// channelID : The ID of a Channel.
-// contentID : The ID of a Content.
-// emoteID   : The ID of an Emote.
-func (s *Session) ChannelContentReactionAdd(channelID, contentID string, emoteID int) error {
-	_, err := s.Request("PUT", EndpointChannelReaction(channelID, contentID, fmt.Sprintf("%d", emoteID)), nil)
-	return err
+	var st struct {
+		CategoryRolePermission *CategoryRolePermission `json:"categoryRolePermission"`
+	}
+	err = unmarshal(body, &st)
+	return st.CategoryRolePermission, err
 }
 
-// ChannelContentReactionDelete deletes a reaction to a content in a channel.
-// channelID : The ID of a Channel.
-// contentID : The ID of a Content.
-// emoteID   : The ID of an Emote.
-func (s *Session) ChannelContentReactionDelete(channelID, contentID string, emoteID int) error {
-	_, err := s.Request("DELETE", EndpointChannelReaction(channelID, contentID, fmt.Sprintf("%d", emoteID)), nil)
+// CategoryRolePermissionDelete deletes a role permission override of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+// roleID     : The ID of a Role.
+func (s *Session) CategoryRolePermissionDelete(serverID ServerID, categoryID CategoryID, roleID RoleID) error {
+	_, err := s.Request("DELETE", EndpointServerCategoryPermission(serverID, categoryID, "roles", roleID.String()), nil)
 	return err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded XP
-// ------------------------------------------------------------------------------------------------
-
-// ServerMemberXPAward awards XP to a member of a server.
-// serverID : The ID of a Server.
-// memberID : The ID of a Member.
-// amount   : The amount of XP to award.
-func (s *Session) ServerMemberXPAward(serverID, memberID string, amount int) (int, error) {
-	body, err := s.Request("POST", EndpointServerXPMember(serverID, memberID), &ServerXPUpdate{Amount: amount})
+// CategoryUserPermissionCreate creates a user permission override of a category.
+// serverID    : The ID of a Server.
+// categoryID  : The ID of a Category.
+// userID      : The ID of a User.
+// permissions : The permissions to allow or deny.
+func (s *Session) CategoryUserPermissionCreate(serverID ServerID, categoryID CategoryID, userID UserID, permissions PermissionOverrides) (*CategoryUserPermission, error) {
+	body, err := s.Request("POST", EndpointServerCategoryPermission(serverID, categoryID, "users", userID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	var st struct {
-		Amount int `json:"amount"`
+		CategoryUserPermission *CategoryUserPermission `json:"categoryUserPermission"`
 	}
 	err = unmarshal(body, &st)
-	return st.Amount, err
+	return st.CategoryUserPermission, err
 }
 
-// ServerMemberXPSet sets role XP to a role of a server.
-// serverID : The ID of a Server.
-// memberID   : The ID of a Member.
-// total   : The amount of XP set.
-func (s *Session) ServerMemberXPSet(serverID, memberID string, total int) (int, error) {
-	body, err := s.Request("PUT", EndpointServerXPMember(serverID, memberID), &ServerXPSet{Total: total})
+// CategoryUserPermission returns a user permission override of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+// userID     : The ID of a User.
+func (s *Session) CategoryUserPermission(serverID ServerID, categoryID CategoryID, userID UserID) (*CategoryUserPermission, error) {
+	body, err := s.Request("GET", EndpointServerCategoryPermission(serverID, categoryID, "users", userID.String()), nil)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	var st struct {
-		Total int `json:"total"`
+		CategoryUserPermission *CategoryUserPermission `json:"categoryUserPermission"`
 	}
-
 	err = unmarshal(body, &st)
-	return st.Total, err
+	return st.CategoryUserPermission, err
 }
 
-// ServerRoleXPAward awards XP to a role of a server.
-// serverID : The ID of a Server.
-// roleID   : The ID of a Role.
-// amount   : The amount of XP to award.
-func (s *Session) ServerRoleXPAward(serverID, roleID string, amount int) (int, error) {
-	body, err := s.Request("POST", EndpointServerXPRoles(serverID, roleID), &ServerXPUpdate{Amount: amount})
+// CategoryUserPermissions returns an array of the user permission overrides of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+func (s *Session) CategoryUserPermissions(serverID ServerID, categoryID CategoryID) ([]*CategoryUserPermission, error) {
+	body, err := s.Request("GET", EndpointServerCategoryPermissions(serverID, categoryID, "users"), nil)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	var st struct {
-		Amount int `json:"amount"`
+		CategoryUserPermissions []*CategoryUserPermission `json:"categoryUserPermissions"`
 	}
 	err = unmarshal(body, &st)
-	return st.Amount, err
+	return st.CategoryUserPermissions, err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Social Links
-// ------------------------------------------------------------------------------------------------
-
-// ServerMemberSocialLink returns a social link of a member of a server.
-// serverID : The ID of a Server.
-// memberID : The ID of a Member.
-// linkType : The type of the social-link.
-func (s *Session) ServerMemberSocialLink(serverID, memberID, linkType string) (*ServerSocialLink, error) {
-	body, err := s.Request("GET", EndpointServerMemberSocialLink(serverID, memberID, linkType), nil)
+// CategoryUserPermissionUpdate updates a user permission override of a category.
+// serverID    : The ID of a Server.
+// categoryID  : The ID of a Category.
+// userID      : The ID of a User.
+// permissions : The permissions to allow or deny.
+func (s *Session) CategoryUserPermissionUpdate(serverID ServerID, categoryID CategoryID, userID UserID, permissions PermissionOverrides) (*CategoryUserPermission, error) {
+	body, err := s.Request("PATCH", EndpointServerCategoryPermission(serverID, categoryID, "users", userID.String()), &PermissionOverridesUpdate{Permissions: permissions})
 	if err != nil {
 		return nil, err
 	}
 
-	var st *ServerSocialLink
+	var st struct {
+		CategoryUserPermission *CategoryUserPermission `json:"categoryUserPermission"`
+	}
 	err = unmarshal(body, &st)
-	return st, err
+	return st.CategoryUserPermission, err
 }
 
-// ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Group Membership
-// ------------------------------------------------------------------------------------------------
-
-// GroupMemberAdd adds a member to a group.
-// groupID : The ID of a Group.
-// memberID : The ID of a Member.
-func (s *Session) GroupMemberAdd(groupID, userID string) error {
-	_, err := s.Request("PUT", EndpointGroupMember(groupID, userID), nil)
+// CategoryUserPermissionDelete deletes a user permission override of a category.
+// serverID   : The ID of a Server.
+// categoryID : The ID of a Category.
+// userID     : The ID of a User.
+func (s *Session) CategoryUserPermissionDelete(serverID ServerID, categoryID CategoryID, userID UserID) error {
+	_, err := s.Request("DELETE", EndpointServerCategoryPermission(serverID, categoryID, "users", userID.String()), nil)
 	return err
 }
 
-// GroupMemberRemove removes a member from a group.
-// groupID : The ID of a Group.
-// memberID : The ID of a Member.
-func (s *Session) GroupMemberRemove(groupID, userID string) error {
-	_, err := s.Request("DELETE", EndpointGroupMember(groupID, userID), nil)
-	return err
+// ServerMemberChannelPermissions returns the effective permissions of a member in a channel,
+// combining the member roles, the channel and category overrides and server ownership.
+// See MemberPermissions.
+// serverID  : The ID of a Server.
+// channelID : The ID of a Channel.
+// userID    : The ID of a User.
+func (s *Session) ServerMemberChannelPermissions(serverID ServerID, channelID ChannelID, userID UserID) (Permissions, error) {
+	member, err := s.ServerMemberGet(serverID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsOwner {
+		return MemberPermissions(member, nil, nil), nil
+	}
+
+	roles, err := s.ServerRoles(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.ChannelGet(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &ChannelPermissionOverrides{}
+	if o.Roles, err = s.ChannelRolePermissions(serverID, channelID); err != nil {
+		return nil, err
+	}
+	if o.User, err = s.ChannelUserPermission(serverID, channelID, userID); err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	if channel.CategoryId != 0 {
+		if o.CategoryRoles, err = s.CategoryRolePermissions(serverID, channel.CategoryId); err != nil {
+			return nil, err
+		}
+		if o.CategoryUser, err = s.CategoryUserPermission(serverID, channel.CategoryId, userID); err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return MemberPermissions(member, roles, o), nil
 }
 
 // ------------------------------------------------------------------------------------------------
-// Functions specific to Guilded Role Membership
+// Functions specific to Guilded Media
 // ------------------------------------------------------------------------------------------------
 
-// ServerMemberRoleAdd adds a role to a member of a server.
-// serverID : The ID of a Server.
-// memberID : The ID of a Member.
-// roleID   : The ID of a Role.
-func (s *Session) ServerMemberRoleAdd(serverID, memberID string, roleID int) error {
-	_, err := s.Request("PUT", EndpointServerMemberRole(serverID, memberID, fmt.Sprintf("%d", roleID)), nil)
-	return err
-}
-
-// ServerMemberRoleRemove removes a role from a member of a server.
-// serverID : The ID of a Server.
-// memberID : The ID of a Member.
-// roleID   : The ID of a Role.
-func (s *Session) ServerMemberRoleRemove(serverID, memberID string, roleID int) error {
-	_, err := s.Request("DELETE", EndpointServerMemberRole(serverID, memberID, fmt.Sprintf("%d", roleID)), nil)
-	return err
-}
+// MediaUpload uploads a file to the Guilded media server and returns its hosted URL.
+// The URL can be used in embeds, for example in ChatEmbedImage.URL.
+// The content type is detected from the file name or content when not set.
+// Returns ErrFileTooLarge if the file is larger than Session.MaxUploadSize.
+// mediaType : The type of media, usually MediaTypeContent.
+// file      : The file to upload.
+func (s *Session) MediaUpload(mediaType MediaType, file *File) (string, error) {
+	contentType, upload := multipartPipe(func(mw *multipart.Writer) error {
+		return writeFilePart(mw, "file", file, s.MaxUploadSize)
+	})
+	defer upload.Close()
 
-// ServerMemberRoles returns a list of roles of a member of a server.
-// serverID : The ID of a Server.
-// memberID : The ID of a Member.
-func (s *Session) ServerMemberRoles(serverID, memberID string) ([]int, error) {
-	body, err := s.Request("GET", EndpointServerMemberRoles(serverID, memberID), nil)
+	body, err := s.requestStream("POST", EndpointMediaUpload(string(mediaType)), contentType, upload)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	var st struct {
-		RoleIDs []int `json:"roleIds"`
+		URL string `json:"url"`
 	}
 	err = unmarshal(body, &st)
-	return st.RoleIDs, err
+	return st.URL, err
 }
 
 // ------------------------------------------------------------------------------------------------
@@ -1047,7 +2671,7 @@ func (s *Session) ServerMemberRoles(serverID, memberID string) ([]int, error) {
 // ServerWebhookCreate creates a webhook in a server.
 // serverID : The ID of a Server.
 // data	    : The data for the webhook.
-func (s *Session) ServerWebhookCreate(serverID string, data *WebhookCreate) (*Webhook, error) {
+func (s *Session) ServerWebhookCreate(serverID ServerID, data *WebhookCreate) (*Webhook, error) {
 	body, err := s.Request("POST", EndpointServerWeebhooks(serverID), data)
 	if err != nil {
 		return nil, err
@@ -1061,7 +2685,7 @@ func (s *Session) ServerWebhookCreate(serverID string, data *WebhookCreate) (*We
 // ServerWebhook returns a webhook in a server.
 // serverID  : The ID of a Server.
 // webhookID : The ID of a Webhook.
-func (s *Session) ServerWebhook(serverID, webhookID string) (*Webhook, error) {
+func (s *Session) ServerWebhook(serverID ServerID, webhookID WebhookID) (*Webhook, error) {
 	body, err := s.Request("GET", EndpointServerWeebhook(serverID, webhookID), nil)
 	if err != nil {
 		return nil, err
@@ -1074,7 +2698,7 @@ func (s *Session) ServerWebhook(serverID, webhookID string) (*Webhook, error) {
 
 // ServerWebhooks returns a list of webhooks in a server.
 // serverID : The ID of a Server.
-func (s *Session) ServerWebhooks(serverID string) ([]*Webhook, error) {
+func (s *Session) ServerWebhooks(serverID ServerID) ([]*Webhook, error) {
 	body, err := s.Request("GET", EndpointServerWeebhooks(serverID), nil)
 	if err != nil {
 		return nil, err
@@ -1091,7 +2715,7 @@ func (s *Session) ServerWebhooks(serverID string) ([]*Webhook, error) {
 // serverID  : The ID of a Server.
 // webhookID : The ID of a Webhook.
 // data	     : The data for the webhook.
-func (s *Session) ServerWebhookUpdate(serverID, webhookID string, data *WebhookUpdate) (*Webhook, error) {
+func (s *Session) ServerWebhookUpdate(serverID ServerID, webhookID WebhookID, data *WebhookUpdate) (*Webhook, error) {
 	body, err := s.Request("PUT", EndpointServerWeebhook(serverID, webhookID), data)
 	if err != nil {
 		return nil, err
@@ -1105,7 +2729,7 @@ func (s *Session) ServerWebhookUpdate(serverID, webhookID string, data *WebhookU
 // ServerWebhookDelete deletes a webhook in a server.
 // serverID  : The ID of a Server.
 // webhookID : The ID of a Webhook.
-func (s *Session) ServerWebhookDelete(serverID, webhookID string) error {
+func (s *Session) ServerWebhookDelete(serverID ServerID, webhookID WebhookID) error {
 	_, err := s.Request("DELETE", EndpointServerWeebhook(serverID, webhookID), nil)
 	return err
 }
@@ -0,0 +1,297 @@
+package guildrone
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNilState is returned when the State of a Session is nil.
+var ErrNilState = errors.New("state not instantiated, please use guildrone.New() or assign Session.State")
+
+// ErrStateNotFound is returned when the State does not hold the requested data.
+var ErrStateNotFound = errors.New("state cache not found")
+
+// State caches the data received from Guilded.
+// Server members are seeded with Session.SyncMembers and kept up to date
+// by the member events.
+type State struct {
+	sync.RWMutex
+
+	// server ID -> user ID -> member
+	members map[ServerID]map[UserID]*ServerMember
+}
+
+// NewState creates an empty State.
+func NewState() *State {
+	return &State{
+		members: map[ServerID]map[UserID]*ServerMember{},
+	}
+}
+
+// Member returns a cached member of a server.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (st *State) Member(serverID ServerID, userID UserID) (*ServerMember, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	m, ok := st.members[serverID][userID]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+	return m, nil
+}
+
+// Members returns the cached members of a server.
+// serverID : The ID of a Server.
+func (st *State) Members(serverID ServerID) []*ServerMember {
+	st.RLock()
+	defer st.RUnlock()
+
+	members := make([]*ServerMember, 0, len(st.members[serverID]))
+	for _, m := range st.members[serverID] {
+		members = append(members, m)
+	}
+	return members
+}
+
+// MemberAdd adds or replaces a member of a server in the cache.
+// serverID : The ID of a Server.
+// member   : The member to cache.
+func (st *State) MemberAdd(serverID ServerID, member *ServerMember) {
+	st.Lock()
+	defer st.Unlock()
+
+	st.memberAdd(serverID, member)
+}
+
+// MemberRemove removes a member of a server from the cache.
+// serverID : The ID of a Server.
+// userID   : The ID of a User.
+func (st *State) MemberRemove(serverID ServerID, userID UserID) {
+	st.Lock()
+	defer st.Unlock()
+
+	delete(st.members[serverID], userID)
+}
+
+func (st *State) memberAdd(serverID ServerID, member *ServerMember) {
+	members, ok := st.members[serverID]
+	if !ok {
+		members = map[UserID]*ServerMember{}
+		st.members[serverID] = members
+	}
+	members[member.User.ID] = member
+}
+
+// MemberSync holds the differences found by Session.SyncMembers between
+// the members of a server and the members cached in the State.
+type MemberSync struct {
+	ServerID ServerID
+
+	// Members missing from the cache
+	Added []*ServerMember
+
+	// Cached members whose name, avatar or roles changed
+	Updated []*ServerMember
+
+	// Cached members no longer in the server
+	Removed []*ServerMember
+}
+
+// syncMembers replaces the cached members of a server.
+// Nicknames, join dates and ownership are not part of the member list,
+// they are kept from the cached members.
+func (st *State) syncMembers(serverID ServerID, summaries []*ServerMemberSummary) *MemberSync {
+	st.Lock()
+	defer st.Unlock()
+
+	diff := &MemberSync{ServerID: serverID}
+	old := st.members[serverID]
+	members := make(map[UserID]*ServerMember, len(summaries))
+	for _, sm := range summaries {
+		m := &ServerMember{
+			User: User{
+				ID:     sm.User.ID,
+				Type:   sm.User.Type,
+				Name:   sm.User.Name,
+				Avatar: sm.User.Avatar,
+			},
+			RoleIds: sm.RoleIds,
+		}
+
+		prev, ok := old[m.User.ID]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, m)
+		default:
+			m.User.Banner = prev.User.Banner
+			m.User.CreatedAt = prev.User.CreatedAt
+			m.User.Status = prev.User.Status
+			m.Nickname = prev.Nickname
+			m.JoinedAt = prev.JoinedAt
+			m.IsOwner = prev.IsOwner
+			if prev.User.Name != m.User.Name || prev.User.Avatar != m.User.Avatar || !equalRoleIds(prev.RoleIds, m.RoleIds) {
+				diff.Updated = append(diff.Updated, m)
+			}
+		}
+		members[m.User.ID] = m
+	}
+
+	for id, m := range old {
+		if _, ok := members[id]; !ok {
+			diff.Removed = append(diff.Removed, m)
+		}
+	}
+
+	st.members[serverID] = members
+	return diff
+}
+
+// onInterface updates the State from an event.
+func (st *State) onInterface(i interface{}) {
+	st.Lock()
+	defer st.Unlock()
+
+	switch t := i.(type) {
+	case *TeamMemberJoined:
+		m := t.Member
+		st.memberAdd(t.ServerID, &m)
+	case *TeamMemberRemoved:
+		delete(st.members[t.ServerID], t.UserID)
+	case *TeamMemberUpdated:
+		if m, ok := st.members[t.ServerID][t.UserInfo.ID]; ok {
+			c := *m
+			c.Nickname = t.UserInfo.Nickname
+			st.members[t.ServerID][t.UserInfo.ID] = &c
+		}
+	case *TeamRolesUpdated:
+		for _, r := range t.MemberRoleIds {
+			if m, ok := st.members[t.ServerID][r.UserID]; ok {
+				c := *m
+				c.RoleIds = r.RoleIDs
+				st.members[t.ServerID][r.UserID] = &c
+			}
+		}
+	}
+}
+
+// equalRoleIds returns true if both members have the same roles, in any order.
+func equalRoleIds(a, b []RoleID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	seen := make(map[RoleID]int, len(a))
+	for _, id := range a {
+		seen[id]++
+	}
+	for _, id := range b {
+		if seen[id] == 0 {
+			return false
+		}
+		seen[id]--
+	}
+	return true
+}
+
+// MemberIterator iterates over a member list, decoding one member per
+// call to Next instead of the whole list at once. The raw response body
+// it reads from is already held in memory.
+//
+//	it, err := s.ServerMembersIter(serverID)
+//	for it.Next() {
+//		member := it.Member()
+//	}
+//	err = it.Err()
+type MemberIterator struct {
+	dec     *json.Decoder
+	started bool
+	done    bool
+	member  *ServerMemberSummary
+	err     error
+}
+
+func newMemberIterator(body []byte) *MemberIterator {
+	return &MemberIterator{dec: json.NewDecoder(bytes.NewReader(body))}
+}
+
+// Next decodes the next member, it returns false at the end of the
+// list or on error.
+func (it *MemberIterator) Next() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		found, err := it.seekMembers()
+		if err != nil || !found {
+			it.err = err
+			it.done = true
+			return false
+		}
+	}
+
+	if !it.dec.More() {
+		it.done = true
+		return false
+	}
+
+	var m ServerMemberSummary
+	if err := it.dec.Decode(&m); err != nil {
+		it.err = fmt.Errorf("%w: %s", ErrJSONUnmarshal, err)
+		return false
+	}
+	it.member = &m
+	return true
+}
+
+// Member returns the member decoded by the last call to Next.
+func (it *MemberIterator) Member() *ServerMemberSummary {
+	return it.member
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MemberIterator) Err() error {
+	return it.err
+}
+
+// seekMembers moves the decoder to the first element of the "members" array.
+// Returns false if the response has no member list.
+func (it *MemberIterator) seekMembers() (bool, error) {
+	if err := it.expectDelim('{'); err != nil {
+		return false, err
+	}
+
+	for it.dec.More() {
+		tok, err := it.dec.Token()
+		if err != nil {
+			return false, fmt.Errorf("%w: %s", ErrJSONUnmarshal, err)
+		}
+		if key, _ := tok.(string); key == "members" {
+			return true, it.expectDelim('[')
+		}
+
+		var skip json.RawMessage
+		if err = it.dec.Decode(&skip); err != nil {
+			return false, fmt.Errorf("%w: %s", ErrJSONUnmarshal, err)
+		}
+	}
+
+	return false, nil
+}
+
+func (it *MemberIterator) expectDelim(d json.Delim) error {
+	tok, err := it.dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrJSONUnmarshal, err)
+	}
+	if tok != d {
+		return fmt.Errorf("%w: expected %s, got %v", ErrJSONUnmarshal, d, tok)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package guildrone
 
 import (
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -44,9 +45,22 @@ type Session struct {
 	// Max number of REST API retries
 	MaxRestRetries int
 
+	// Max size in bytes of a file uploaded with MediaUpload, 0 means unlimited
+	MaxUploadSize int64
+
+	// Should the State be updated by events
+	StateEnabled bool
+
+	// Cache of the data received from Guilded
+	State *State
+
 	// Should the session reconnect the websocket on errors.
 	ShouldReconnectOnError bool
 
+	// Policy used when reconnecting the websocket.
+	// DefaultReconnectPolicy is used when nil.
+	ReconnectPolicy ReconnectPolicy
+
 	// ID of the last websocket event message
 	eventMu     sync.RWMutex
 	LastEventID string
@@ -54,6 +68,25 @@ type Session struct {
 	// Should replay missed events on websocket reconnect
 	ShouldReplayEventsOnReconnect bool
 
+	// The authenticated bot user, set once the welcome message is received.
+	// Use CurrentUser to read it.
+	userMu sync.RWMutex
+	user   *BotUser
+
+	// Should chat messages created or updated by the bot user be ignored.
+	ShouldIgnoreOwnMessages bool
+
+	// Should chat, topic and list events created by webhooks be ignored.
+	ShouldIgnoreWebhookMessages bool
+
+	// Should events of private chat messages be ignored.
+	ShouldIgnorePrivateMessages bool
+
+	// Servers and channels chat, topic, doc and list events, and the reactions
+	// to them, are dispatched for.
+	ServerFilter  IDFilter[ServerID]
+	ChannelFilter IDFilter[ChannelID]
+
 	// Should the session retry requests when rate limited.
 	ShouldRetryOnRateLimit bool
 
@@ -83,40 +116,110 @@ type Session struct {
 
 	// used to make sure gateway websocket writes do not happen concurrently
 	wsMutex sync.Mutex
+
+	// Connection health reported by Manager.Health. It has its own lock
+	// because open holds the Session lock while connecting.
+	healthMu sync.RWMutex
+	health   SessionHealth
+
+	// Closed by Close to stop a running reconnect loop.
+	reconnectMu   sync.Mutex
+	reconnectStop chan struct{}
 }
 
 type ServerMember struct {
-	User     User   `json:"user"`
-	RoleIds  []int  `json:"roleIds"`
-	Nickname string `json:"nickname"`
-	JoinedAt string `json:"joinedAt"`
-	IsOwner  bool   `json:"isOwner"`
+	User     User      `json:"user"`
+	RoleIds  []RoleID  `json:"roleIds"`
+	Nickname string    `json:"nickname"`
+	JoinedAt time.Time `json:"joinedAt"`
+	IsOwner  bool      `json:"isOwner"`
+}
+
+// ServerMemberSummary is a member of a server as returned by the member list.
+type ServerMemberSummary struct {
+	User    UserSummary `json:"user"`
+	RoleIds []RoleID    `json:"roleIds"`
 }
 
 type UserType string
 
 type UserSummary struct {
-	ID     string `json:"id"`
-	Type   string `json:"type"`
-	Name   string `json:"name"`
-	Avatar string `json:"avatar"`
+	ID     UserID   `json:"id"`
+	Type   UserType `json:"type"`
+	Name   string   `json:"name"`
+	Avatar string   `json:"avatar"`
+}
+
+// IsBot returns true if the user is a bot.
+func (u *UserSummary) IsBot() bool {
+	return u.Type == UserTypeBot
 }
 
 type UserInfo struct {
-	ID       string `json:"id"`
+	ID       UserID `json:"id"`
 	Nickname string `json:"nickname"`
 }
 
 type ServerMemberBan struct {
 	UserSummary UserSummary `json:"user"`
 	Reason      string      `json:"reason"`
-	CreatedBy   string      `json:"createdBy"`
+	CreatedBy   UserID      `json:"createdBy"`
 	CreatedAt   time.Time   `json:"createdAt"`
 }
 
+// Role is the server role model
+type Role struct {
+	ID                    RoleID      `json:"id"`
+	ServerID              ServerID    `json:"serverId"`
+	CreatedAt             time.Time   `json:"createdAt"`
+	UpdatedAt             *time.Time  `json:"updatedAt,omitempty"`
+	Name                  string      `json:"name"`
+	IsDisplayedSeparately bool        `json:"isDisplayedSeparately"`
+	IsSelfAssignable      bool        `json:"isSelfAssignable"`
+	IsMentionable         bool        `json:"isMentionable"`
+	Permissions           Permissions `json:"permissions"`
+	// Colors of the role, a second color makes a gradient
+	Colors []int  `json:"colors,omitempty"`
+	Icon   string `json:"icon,omitempty"`
+	// Position of the role, higher positions are listed first
+	Position int `json:"position"`
+	// Whether this is the base role every member has
+	IsBase bool `json:"isBase,omitempty"`
+	// ID of the bot user, for roles managed by a bot
+	BotUserID UserID `json:"botUserId,omitempty"`
+}
+
+// Color returns the primary color of the role, 0 if it has none.
+func (r *Role) Color() int {
+	if len(r.Colors) == 0 {
+		return 0
+	}
+	return r.Colors[0]
+}
+
+// Roles returns the roles of the member found in roles, ordered by
+// descending position.
+func (m *ServerMember) Roles(roles []*Role) []*Role {
+	ids := make(map[RoleID]bool, len(m.RoleIds))
+	for _, id := range m.RoleIds {
+		ids[id] = true
+	}
+
+	var st []*Role
+	for _, r := range roles {
+		if ids[r.ID] {
+			st = append(st, r)
+		}
+	}
+	sort.SliceStable(st, func(i, j int) bool {
+		return st[i].Position > st[j].Position
+	})
+	return st
+}
+
 type MemberRole struct {
-	UserID  string `json:"userId"`
-	RoleIDs []int  `json:"roleId"`
+	UserID  UserID   `json:"userId"`
+	RoleIDs []RoleID `json:"roleIds"`
 }
 
 // Mentions stores the data of a mention
@@ -133,63 +236,203 @@ type Mentions struct {
 type ServerChannelType string
 
 type ServerChannel struct {
-	ID         string            `json:"id"`
+	ID         ChannelID         `json:"id"`
 	Type       ServerChannelType `json:"type"`
 	Name       string            `json:"name"`
 	Topic      string            `json:"topic"`
 	CreatedAt  time.Time         `json:"createdAt"`
-	CreatedBy  string            `json:"createdBy"`
+	CreatedBy  UserID            `json:"createdBy"`
 	UpdatedAt  *time.Time        `json:"updatedAt"`
-	ServerId   string            `json:"serverId"`
-	ParentId   string            `json:"parentId"`
-	CategoryId int               `json:"categoryId"`
-	GroupId    string            `json:"groupId"`
+	ServerId   ServerID          `json:"serverId"`
+	ParentId   ChannelID         `json:"parentId"`
+	CategoryId CategoryID        `json:"categoryId"`
+	GroupId    GroupID           `json:"groupId"`
 	IsPublic   bool              `json:"isPublic"`
-	ArchivedBy string            `json:"archivedBy"`
-	ArchivedAt string            `json:"archivedAt"`
+	ArchivedBy UserID            `json:"archivedBy"`
+	ArchivedAt *time.Time        `json:"archivedAt,omitempty"`
+}
+
+// Group is a group of a server.
+// Groups hold the categories and channels of a server.
+type Group struct {
+	ID          GroupID    `json:"id"`
+	ServerID    ServerID   `json:"serverId"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Avatar      string     `json:"avatar,omitempty"`
+	IsHome      bool       `json:"isHome,omitempty"`
+	EmoteID     EmoteID    `json:"emoteId,omitempty"`
+	IsPublic    bool       `json:"isPublic,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CreatedBy   UserID     `json:"createdBy"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	UpdatedBy   UserID     `json:"updatedBy,omitempty"`
+	ArchivedAt  *time.Time `json:"archivedAt,omitempty"`
+	ArchivedBy  UserID     `json:"archivedBy,omitempty"`
+}
+
+// Category is a channel category of a server.
+type Category struct {
+	ID        CategoryID `json:"id"`
+	ServerID  ServerID   `json:"serverId"`
+	GroupID   GroupID    `json:"groupId"`
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 type Webhook struct {
-	ID        string     `json:"id"`
+	ID        WebhookID  `json:"id"`
 	Name      string     `json:"name"`
-	ServerId  string     `json:"serverId"`
-	ChannelId string     `json:"channelId"`
+	ServerId  ServerID   `json:"serverId"`
+	ChannelId ChannelID  `json:"channelId"`
 	CreatedAt time.Time  `json:"createdAt"`
-	CreatedBy string     `json:"createdBy"`
+	CreatedBy UserID     `json:"createdBy"`
 	DeletedAt *time.Time `json:"deletedAt"`
 	Token     string     `json:"token"`
 }
 
 type Doc struct {
-	ID        int        `json:"id"`
-	ServerId  string     `json:"serverId"`
-	ChannelId string     `json:"channelId"`
+	ID        DocID      `json:"id"`
+	ServerId  ServerID   `json:"serverId"`
+	ChannelId ChannelID  `json:"channelId"`
 	Title     string     `json:"title"`
 	Content   string     `json:"content"`
 	Mentions  *Mentions  `json:"mentions"`
 	CreatedAt time.Time  `json:"createdAt"`
-	CreatedBy string     `json:"createdBy"`
+	CreatedBy UserID     `json:"createdBy"`
 	UpdatedAt *time.Time `json:"updatedAt"`
-	UpdatedBy string     `json:"updatedBy"`
+	UpdatedBy UserID     `json:"updatedBy"`
+}
+
+// DocComment is the doc comment model
+type DocComment struct {
+	ID        DocCommentID `json:"id"`
+	Content   string       `json:"content"`
+	CreatedAt time.Time    `json:"createdAt"`
+	CreatedBy UserID       `json:"createdBy"`
+	UpdatedAt *time.Time   `json:"updatedAt,omitempty"`
+	ChannelID ChannelID    `json:"channelId"`
+	DocID     DocID        `json:"docId"`
+	Mentions  *Mentions    `json:"mentions,omitempty"`
+}
+
+// Announcement is the announcement model
+type Announcement struct {
+	ID        AnnouncementID `json:"id"`
+	ServerID  ServerID       `json:"serverId"`
+	ChannelID ChannelID      `json:"channelId"`
+	Title     string         `json:"title"`
+	Content   string         `json:"content"`
+	Mentions  *Mentions      `json:"mentions,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	CreatedBy UserID         `json:"createdBy"`
+}
+
+// AnnouncementComment is the announcement comment model
+type AnnouncementComment struct {
+	ID             AnnouncementCommentID `json:"id"`
+	Content        string                `json:"content"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	CreatedBy      UserID                `json:"createdBy"`
+	UpdatedAt      *time.Time            `json:"updatedAt,omitempty"`
+	ChannelID      ChannelID             `json:"channelId"`
+	AnnouncementID AnnouncementID        `json:"announcementId"`
+	Mentions       *Mentions             `json:"mentions,omitempty"`
 }
 
 type CalendarEvent struct {
-	ID          string    `json:"id"`
-	ServerId    string    `json:"serverId"`
-	ChannelId   string    `json:"channelId"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Location    string    `json:"location"`
-	URL         string    `json:"url"`
-	Color       int       `json:"color"`
-	StartsAt    time.Time `json:"startsAt"`
+	ID          CalendarEventID `json:"id"`
+	ServerId    ServerID        `json:"serverId"`
+	ChannelId   ChannelID       `json:"channelId"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Location    string          `json:"location"`
+	URL         string          `json:"url"`
+	Color       int             `json:"color"`
+	StartsAt    time.Time       `json:"startsAt"`
 	// Duration in minutes
-	Duration     int          `json:"duration"`
-	IsPrivate    bool         `json:"isPrivate"`
-	Mentions     *Mentions    `json:"mentions"`
-	CreatedAt    time.Time    `json:"createdAt"`
-	CreatedBy    string       `json:"createdBy"`
-	Cancellation Cancellation `json:"cancellation"`
+	Duration     int           `json:"duration"`
+	IsPrivate    bool          `json:"isPrivate"`
+	Mentions     *Mentions     `json:"mentions"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	CreatedBy    UserID        `json:"createdBy"`
+	Cancellation *Cancellation `json:"cancellation,omitempty"`
+	// ID of the series of a repeating event
+	SeriesID CalendarEventSeriesID `json:"seriesId,omitempty"`
+	Repeats  bool                  `json:"repeats,omitempty"`
+}
+
+// CalendarEventSeries is the calendar event series model
+type CalendarEventSeries struct {
+	ID        CalendarEventSeriesID `json:"id"`
+	ServerID  ServerID              `json:"serverId"`
+	ChannelID ChannelID             `json:"channelId"`
+}
+
+// CalendarEventRepeatType is the way a calendar event repeats.
+type CalendarEventRepeatType string
+
+const (
+	CalendarEventRepeatOnce       CalendarEventRepeatType = "once"
+	CalendarEventRepeatEveryDay   CalendarEventRepeatType = "everyDay"
+	CalendarEventRepeatEveryWeek  CalendarEventRepeatType = "everyWeek"
+	CalendarEventRepeatEveryMonth CalendarEventRepeatType = "everyMonth"
+	CalendarEventRepeatCustom     CalendarEventRepeatType = "custom"
+)
+
+// CalendarEventRepeatInterval is the unit of a custom repeat interval.
+type CalendarEventRepeatInterval string
+
+const (
+	CalendarEventRepeatIntervalDay   CalendarEventRepeatInterval = "day"
+	CalendarEventRepeatIntervalWeek  CalendarEventRepeatInterval = "week"
+	CalendarEventRepeatIntervalMonth CalendarEventRepeatInterval = "month"
+	CalendarEventRepeatIntervalYear  CalendarEventRepeatInterval = "year"
+)
+
+// CalendarEventWeekday is a day of the week a weekly event repeats on.
+type CalendarEventWeekday string
+
+const (
+	CalendarEventSunday    CalendarEventWeekday = "sunday"
+	CalendarEventMonday    CalendarEventWeekday = "monday"
+	CalendarEventTuesday   CalendarEventWeekday = "tuesday"
+	CalendarEventWednesday CalendarEventWeekday = "wednesday"
+	CalendarEventThursday  CalendarEventWeekday = "thursday"
+	CalendarEventFriday    CalendarEventWeekday = "friday"
+	CalendarEventSaturday  CalendarEventWeekday = "saturday"
+)
+
+// CalendarEventRepeatInfo is the repeat rule of a calendar event series.
+// EndsAfterOccurrences and EndDate are mutually exclusive, the series is
+// limited to 24 occurrences when neither is set.
+type CalendarEventRepeatInfo struct {
+	Type CalendarEventRepeatType `json:"type" validate:"required,oneof=once everyDay everyWeek everyMonth custom"`
+	// Interval of a custom repeat rule, required when Type is custom
+	Every                *CalendarEventRepeatEvery `json:"every,omitempty" validate:"required_if=Type custom"`
+	EndsAfterOccurrences int                       `json:"endsAfterOccurrences,omitempty" validate:"omitempty,min=1,max=24,excluded_with=EndDate"`
+	EndDate              *time.Time                `json:"endDate,omitempty"`
+	// Days a custom weekly event repeats on
+	On []CalendarEventWeekday `json:"on,omitempty" validate:"omitempty,max=7,dive,oneof=sunday monday tuesday wednesday thursday friday saturday"`
+}
+
+// CalendarEventRepeatEvery is the interval of a custom repeat rule.
+type CalendarEventRepeatEvery struct {
+	Count    int                         `json:"count" validate:"min=1"`
+	Interval CalendarEventRepeatInterval `json:"interval" validate:"required,oneof=day week month year"`
+}
+
+// CalendarEventComment is the calendar event comment model
+type CalendarEventComment struct {
+	ID              CalendarEventCommentID `json:"id"`
+	Content         string                 `json:"content"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	CreatedBy       UserID                 `json:"createdBy"`
+	UpdatedAt       *time.Time             `json:"updatedAt,omitempty"`
+	ChannelID       ChannelID              `json:"channelId"`
+	CalendarEventID CalendarEventID        `json:"calendarEventId"`
+	Mentions        *Mentions              `json:"mentions,omitempty"`
 }
 
 type RsvpStatus string
@@ -203,76 +446,153 @@ const (
 )
 
 type CalendarEventRsvp struct {
-	CalendarEventID int        `json:"calendarEventId"`
-	ChannelID       string     `json:"channelId"`
-	ServerID        string     `json:"serverId"`
-	UserID          string     `json:"userId"`
-	Status          RsvpStatus `json:"status"`
-	CreatedBy       string     `json:"createdBy"`
-	CreatedAt       time.Time  `json:"createdAt"`
-	UpdatedBy       string     `json:"updatedBy"`
-	UpdatedAt       *time.Time `json:"updatedAt"`
+	CalendarEventID CalendarEventID `json:"calendarEventId"`
+	ChannelID       ChannelID       `json:"channelId"`
+	ServerID        ServerID        `json:"serverId"`
+	UserID          UserID          `json:"userId"`
+	Status          RsvpStatus      `json:"status"`
+	CreatedBy       UserID          `json:"createdBy"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedBy       UserID          `json:"updatedBy"`
+	UpdatedAt       *time.Time      `json:"updatedAt"`
 }
 
 type CalendarSetRsvpStatusRrequest struct {
 	Status RsvpStatus `json:"status"`
 }
 
+// Cancellation is the cancellation of a calendar event.
 type Cancellation struct {
 	Description string `json:"description"`
-	CreatedBy   string `json:"createdBy"`
+	CreatedBy   UserID `json:"createdBy"`
+}
+
+// IsCancelled returns true if the calendar event was cancelled.
+func (e *CalendarEvent) IsCancelled() bool {
+	return e.Cancellation != nil
 }
 
 // ListItem is a struct that represents a list item.
 type ListItem struct {
-	ID                 string        `json:"id"`
-	ServerID           string        `json:"serverId"`
-	ChannelID          string        `json:"channelId"`
+	ID                 ListItemID    `json:"id"`
+	ServerID           ServerID      `json:"serverId"`
+	ChannelID          ChannelID     `json:"channelId"`
 	Message            string        `json:"message"`
 	Mentions           *Mentions     `json:"mentions"`
 	CreatedAt          time.Time     `json:"createdAt"`
-	CreatedBy          string        `json:"createdBy"`
-	CreatedByWebhookId string        `json:"createdByWebhookId"`
+	CreatedBy          UserID        `json:"createdBy"`
+	CreatedByWebhookId WebhookID     `json:"createdByWebhookId"`
 	UpdatedAt          *time.Time    `json:"updatedAt"`
-	UpdatedBy          string        `json:"updatedBy"`
-	ParentListItemId   string        `json:"parentListItemId"`
-	CompletedAt        string        `json:"completedAt"`
-	CompletedBy        string        `json:"completedBy"`
+	UpdatedBy          UserID        `json:"updatedBy"`
+	ParentListItemId   ListItemID    `json:"parentListItemId"`
+	CompletedAt        *time.Time    `json:"completedAt,omitempty"`
+	CompletedBy        UserID        `json:"completedBy"`
 	Note               *ListItemNote `json:"note"`
 }
 
 // ListItemNote is a struct that represents a list item note.
 type ListItemNote struct {
 	CreatedAt time.Time  `json:"createdAt"`
-	CreatedBy string     `json:"createdBy"`
+	CreatedBy UserID     `json:"createdBy"`
 	UpdatedAt *time.Time `json:"updatedAt"`
-	UpdatedBy string     `json:"updatedBy"`
+	UpdatedBy UserID     `json:"updatedBy"`
 	Mentions  *Mentions  `json:"mentions"`
 	Content   string     `json:"content"`
 }
 
 // Reaction is a struct that represents a reaction.
 type Reaction struct {
-	ChannelID string `json:"channelId"`
-	MessageID string `json:"messageId"`
-	CreatedBy string `json:"createdBy"`
-	Emote     Emote  `json:"emote"`
+	ChannelID ChannelID `json:"channelId"`
+	MessageID MessageID `json:"messageId"`
+	CreatedBy UserID    `json:"createdBy"`
+	Emote     Emote     `json:"emote"`
 }
 
 // Emote is a struct that represents a reaction emote.
+// Custom emotes belong to a server, unicode emotes are available everywhere.
 type Emote struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
-	URL  string `json:"url"`
+	ID       EmoteID  `json:"id"`
+	Name     string   `json:"name"`
+	URL      string   `json:"url"`
+	ServerID ServerID `json:"serverId,omitempty"`
+}
+
+// IsCustom returns true if the emote was uploaded to a server.
+func (e *Emote) IsCustom() bool {
+	return e.ServerID != ""
+}
+
+// IsUnicode returns true if the emote is a built-in unicode emote.
+func (e *Emote) IsUnicode() bool {
+	return e.ServerID == ""
+}
+
+// ForumTopicReaction is a struct that represents a reaction to a forum topic.
+type ForumTopicReaction struct {
+	ChannelID    ChannelID    `json:"channelId"`
+	CreatedBy    UserID       `json:"createdBy"`
+	Emote        Emote        `json:"emote"`
+	ForumTopicID ForumTopicID `json:"forumTopicId"`
+}
+
+// ForumTopicCommentReaction is a struct that represents a reaction to a forum topic comment.
+type ForumTopicCommentReaction struct {
+	ChannelID           ChannelID           `json:"channelId"`
+	CreatedBy           UserID              `json:"createdBy"`
+	Emote               Emote               `json:"emote"`
+	ForumTopicID        ForumTopicID        `json:"forumTopicId"`
+	ForumTopicCommentID ForumTopicCommentID `json:"forumTopicCommentId"`
+}
+
+// DocReaction is a struct that represents a reaction to a doc.
+type DocReaction struct {
+	ChannelID ChannelID `json:"channelId"`
+	CreatedBy UserID    `json:"createdBy"`
+	Emote     Emote     `json:"emote"`
+	DocID     DocID     `json:"docId"`
+}
+
+// DocCommentReaction is a struct that represents a reaction to a doc comment.
+type DocCommentReaction struct {
+	ChannelID    ChannelID    `json:"channelId"`
+	CreatedBy    UserID       `json:"createdBy"`
+	Emote        Emote        `json:"emote"`
+	DocID        DocID        `json:"docId"`
+	DocCommentID DocCommentID `json:"docCommentId"`
+}
+
+// CalendarEventReaction is a struct that represents a reaction to a calendar event.
+type CalendarEventReaction struct {
+	ChannelID       ChannelID       `json:"channelId"`
+	CreatedBy       UserID          `json:"createdBy"`
+	Emote           Emote           `json:"emote"`
+	CalendarEventID CalendarEventID `json:"calendarEventId"`
+}
+
+// CalendarEventCommentReaction is a struct that represents a reaction to a calendar event comment.
+type CalendarEventCommentReaction struct {
+	ChannelID              ChannelID              `json:"channelId"`
+	CreatedBy              UserID                 `json:"createdBy"`
+	Emote                  Emote                  `json:"emote"`
+	CalendarEventID        CalendarEventID        `json:"calendarEventId"`
+	CalendarEventCommentID CalendarEventCommentID `json:"calendarEventCommentId"`
+}
+
+// AnnouncementReaction is a struct that represents a reaction to an announcement.
+type AnnouncementReaction struct {
+	ChannelID      ChannelID      `json:"channelId"`
+	CreatedBy      UserID         `json:"createdBy"`
+	Emote          Emote          `json:"emote"`
+	AnnouncementID AnnouncementID `json:"announcementId"`
 }
 
 // BotUser is a bot data structure.
 type BotUser struct {
-	ID        string    `json:"id"`
+	ID        UserID    `json:"id"`
 	BotID     string    `json:"botId"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"createdAt"`
-	CreatedBy string    `json:"createdBy"`
+	CreatedBy UserID    `json:"createdBy"`
 }
 
 // An APIErrorMessage is an api error message returned from Guilded
@@ -296,8 +616,8 @@ type ServerType string
 
 // Server represents a server in Guilded
 type Server struct {
-	ID               string      `json:"id"`
-	OwnerID          string      `json:"ownerId"`
+	ID               ServerID    `json:"id"`
+	OwnerID          UserID      `json:"ownerId"`
 	Type             *ServerType `json:"type,omitempty"`
 	Name             string      `json:"name"`
 	URL              string      `json:"url,omitempty"`
@@ -306,7 +626,7 @@ type Server struct {
 	Banner           string      `json:"banner,omitempty"`
 	Timezone         string      `json:"timezone,omitempty"`
 	IsVerified       bool        `json:"isVerified,omitempty"`
-	DefaultChannelID string      `json:"defaultChannelId,omitempty"`
+	DefaultChannelID ChannelID   `json:"defaultChannelId,omitempty"`
 	CreatedAt        time.Time   `json:"createdAt"`
 }
 
@@ -316,9 +636,9 @@ type ServerChannelCreate struct {
 	Topic      string            `json:"topic,omitempty" validate:"omitempty,min=1,max=512"`
 	IsPublic   bool              `json:"isPublic,omitempty"`
 	Type       ServerChannelType `json:"type" validate:"required"`
-	ServerID   string            `json:"serverId,omitempty"`
-	GroupID    string            `json:"groupId,omitempty"`
-	CategoryID string            `json:"categoryId,omitempty"`
+	ServerID   ServerID          `json:"serverId,omitempty"`
+	GroupID    GroupID           `json:"groupId,omitempty"`
+	CategoryID CategoryID        `json:"categoryId,omitempty"`
 }
 
 // Validate validates the channel create request
@@ -342,6 +662,110 @@ func (c *ServerChannelUpdate) Validate() error {
 	return validate.Struct(c)
 }
 
+// UserStatusUpdate is the request body for setting the custom status of a user
+type UserStatusUpdate struct {
+	Content   string     `json:"content,omitempty" validate:"omitempty,max=256"`
+	EmoteID   EmoteID    `json:"emoteId" validate:"required"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// Validate validates the user status update request
+// Returns nil if valid, otherwise returns an error
+func (c *UserStatusUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ServerGroupCreate is the request body for creating a group
+type ServerGroupCreate struct {
+	Name        string  `json:"name" validate:"required,min=1,max=80"`
+	Description string  `json:"description,omitempty" validate:"omitempty,min=1,max=280"`
+	EmoteID     EmoteID `json:"emoteId,omitempty"`
+	IsPublic    bool    `json:"isPublic,omitempty"`
+}
+
+// Validate validates the group create request
+// Returns nil if valid, otherwise returns an error
+func (c *ServerGroupCreate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ServerGroupUpdate is the request body for updating a group
+type ServerGroupUpdate struct {
+	Name        string  `json:"name,omitempty" validate:"omitempty,min=1,max=80"`
+	Description string  `json:"description,omitempty" validate:"omitempty,min=1,max=280"`
+	EmoteID     EmoteID `json:"emoteId,omitempty"`
+	IsPublic    *bool   `json:"isPublic,omitempty"`
+}
+
+// Validate validates the group update request
+// Returns nil if valid, otherwise returns an error
+func (c *ServerGroupUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ServerCategoryCreate is the request body for creating a category
+type ServerCategoryCreate struct {
+	Name    string  `json:"name" validate:"required,min=1,max=100"`
+	GroupID GroupID `json:"groupId,omitempty"`
+}
+
+// Validate validates the category create request
+// Returns nil if valid, otherwise returns an error
+func (c *ServerCategoryCreate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ServerCategoryUpdate is the request body for updating a category
+type ServerCategoryUpdate struct {
+	Name string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+}
+
+// Validate validates the category update request
+// Returns nil if valid, otherwise returns an error
+func (c *ServerCategoryUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ServerRoleCreate is the request body for creating a server role
+type ServerRoleCreate struct {
+	Name                  string      `json:"name" validate:"min=1,max=128"`
+	IsDisplayedSeparately bool        `json:"isDisplayedSeparately,omitempty"`
+	IsSelfAssignable      bool        `json:"isSelfAssignable,omitempty"`
+	IsMentionable         bool        `json:"isMentionable,omitempty"`
+	Permissions           Permissions `json:"permissions"`
+	Colors                []int       `json:"colors,omitempty" validate:"omitempty,max=2,dive,min=0,max=16777215"`
+}
+
+// Validate validates the server role create request
+// Returns nil if valid, otherwise returns an error
+func (r *ServerRoleCreate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(r)
+}
+
+// ServerRoleUpdate is the request body for updating a server role
+// Nil fields are left unchanged.
+type ServerRoleUpdate struct {
+	Name                  string      `json:"name,omitempty" validate:"omitempty,min=1,max=128"`
+	IsDisplayedSeparately *bool       `json:"isDisplayedSeparately,omitempty"`
+	IsSelfAssignable      *bool       `json:"isSelfAssignable,omitempty"`
+	IsMentionable         *bool       `json:"isMentionable,omitempty"`
+	Permissions           Permissions `json:"permissions,omitempty"`
+	Colors                []int       `json:"colors,omitempty" validate:"omitempty,max=2,dive,min=0,max=16777215"`
+}
+
+// Validate validates the server role update request
+// Returns nil if valid, otherwise returns an error
+func (r *ServerRoleUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(r)
+}
+
 // ServerMemberNicknameUpdate is the request body for updating a server member nickname
 type ServerMemberNicknameUpdate struct {
 	Nickname string `json:"nickname"`
@@ -354,30 +778,44 @@ type ServerMemberBanCreate struct {
 
 // ForumTopic is the forum topic model
 type ForumTopic struct {
-	ID                 int        `json:"id"`
-	ServerID           string     `json:"serverId"`
-	ChannelID          string     `json:"channelId"`
-	Title              string     `json:"title,omitempty"`
-	Content            string     `json:"content,omitempty"`
-	CreatedAt          time.Time  `json:"createdAt"`
-	CreatedBy          string     `json:"createdBy"`
-	CreatedByWebhookId string     `json:"createdByWebhookId,omitempty"`
-	UpdatedAt          *time.Time `json:"updatedAt,omitempty"`
-	BumpedAt           *time.Time `json:"bumpedAt,omitempty"`
-	Mentions           *Mentions  `json:"mentions,omitempty"`
+	ID                 ForumTopicID `json:"id"`
+	ServerID           ServerID     `json:"serverId"`
+	ChannelID          ChannelID    `json:"channelId"`
+	Title              string       `json:"title,omitempty"`
+	Content            string       `json:"content,omitempty"`
+	CreatedAt          time.Time    `json:"createdAt"`
+	CreatedBy          UserID       `json:"createdBy"`
+	CreatedByWebhookId WebhookID    `json:"createdByWebhookId,omitempty"`
+	UpdatedAt          *time.Time   `json:"updatedAt,omitempty"`
+	BumpedAt           *time.Time   `json:"bumpedAt,omitempty"`
+	IsPinned           bool         `json:"isPinned,omitempty"`
+	IsLocked           bool         `json:"isLocked,omitempty"`
+	Mentions           *Mentions    `json:"mentions,omitempty"`
+}
+
+// ForumTopicComment is the forum topic comment model
+type ForumTopicComment struct {
+	ID           ForumTopicCommentID `json:"id"`
+	Content      string              `json:"content"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	UpdatedAt    *time.Time          `json:"updatedAt,omitempty"`
+	ChannelID    ChannelID           `json:"channelId"`
+	ForumTopicID ForumTopicID        `json:"forumTopicId"`
+	CreatedBy    UserID              `json:"createdBy"`
+	Mentions     *Mentions           `json:"mentions,omitempty"`
 }
 
 // ForumTopicSummary is the forum topic summary model
 type ForumTopicSummary struct {
-	ID               int        `json:"id"`
-	ServerID         string     `json:"serverId"`
-	ChannelID        string     `json:"channelId"`
-	Title            string     `json:"title"`
-	CreatedAt        time.Time  `json:"createdAt"`
-	CreatedBy        string     `json:"createdBy"`
-	CreatedByWebhook string     `json:"createdByWebhook,omitempty"`
-	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
-	BumpedAt         *time.Time `json:"bumpedAt,omitempty"`
+	ID               ForumTopicID `json:"id"`
+	ServerID         ServerID     `json:"serverId"`
+	ChannelID        ChannelID    `json:"channelId"`
+	Title            string       `json:"title"`
+	CreatedAt        time.Time    `json:"createdAt"`
+	CreatedBy        UserID       `json:"createdBy"`
+	CreatedByWebhook WebhookID    `json:"createdByWebhook,omitempty"`
+	UpdatedAt        *time.Time   `json:"updatedAt,omitempty"`
+	BumpedAt         *time.Time   `json:"bumpedAt,omitempty"`
 }
 
 // ChannelForumTopicCreate is the request body for creating a forum topic
@@ -405,6 +843,18 @@ func (c *ChannelForumTopicUpdate) Validate() error {
 	return validate.Struct(c)
 }
 
+// ChannelForumTopicComment is the request body for creating or updating a forum topic comment
+type ChannelForumTopicComment struct {
+	Content string `json:"content" validate:"min=1,max=10000"`
+}
+
+// Validate validates the channel forum topic comment create/update request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelForumTopicComment) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
 // ChannelListItem is the request body for creating or updating a channel list item
 type ChannelListItem struct {
 	Message string               `json:"message"`
@@ -427,6 +877,56 @@ func (c *ChannelDoc) Validate() error {
 	return validate.Struct(c)
 }
 
+// ChannelDocComment is the request body for creating or updating a doc comment
+type ChannelDocComment struct {
+	Content string `json:"content" validate:"min=1,max=10000"`
+}
+
+// Validate validates the channel doc comment create/update request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelDocComment) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ChannelAnnouncementCreate is the request body for creating an announcement
+type ChannelAnnouncementCreate struct {
+	Title   string `json:"title" validate:"min=1,max=128"`
+	Content string `json:"content" validate:"min=1,max=4000"`
+}
+
+// Validate validates the channel announcement create request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelAnnouncementCreate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ChannelAnnouncementUpdate is the request body for updating an announcement
+type ChannelAnnouncementUpdate struct {
+	Title   string `json:"title,omitempty" validate:"omitempty,min=1,max=128"`
+	Content string `json:"content,omitempty" validate:"omitempty,min=1,max=4000"`
+}
+
+// Validate validates the channel announcement update request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelAnnouncementUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// ChannelAnnouncementComment is the request body for creating or updating an announcement comment
+type ChannelAnnouncementComment struct {
+	Content string `json:"content" validate:"min=1,max=10000"`
+}
+
+// Validate validates the channel announcement comment create/update request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelAnnouncementComment) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
 // ChannelEvent is the request body for creating/updating a channel event
 type ChannelEvent struct {
 	Name        string     `json:"name" validate:"min=1,max=60"`
@@ -437,6 +937,8 @@ type ChannelEvent struct {
 	Color       int        `json:"color,omitempty" validate:"omitempty,min=0,max=16777215"`
 	Duration    int        `json:"duration,omitempty" validate:"omitempty,min=1"`
 	IsPrivate   bool       `json:"isPrivate,omitempty"`
+	// Makes the event repeat, only used when creating an event
+	RepeatInfo *CalendarEventRepeatInfo `json:"repeatInfo,omitempty"`
 }
 
 // Validate validates the channel event create/update request
@@ -446,6 +948,46 @@ func (e *ChannelEvent) Validate() error {
 	return validate.Struct(e)
 }
 
+// ChannelEventSeriesUpdate is the request body for updating a calendar event series
+type ChannelEventSeriesUpdate struct {
+	Name        string                   `json:"name,omitempty" validate:"omitempty,min=1,max=60"`
+	Description string                   `json:"description,omitempty" validate:"omitempty,min=1,max=8000"`
+	Location    string                   `json:"location,omitempty" validate:"omitempty,min=1,max=8000"`
+	StartsAt    *time.Time               `json:"startsAt,omitempty"`
+	URL         string                   `json:"url,omitempty"`
+	Color       int                      `json:"color,omitempty" validate:"omitempty,min=0,max=16777215"`
+	Duration    int                      `json:"duration,omitempty" validate:"omitempty,min=1"`
+	IsPrivate   *bool                    `json:"isPrivate,omitempty"`
+	RepeatInfo  *CalendarEventRepeatInfo `json:"repeatInfo,omitempty"`
+	// When set, only this event and the following ones are updated
+	CalendarEventID CalendarEventID `json:"calendarEventId,omitempty"`
+}
+
+// Validate validates the channel event series update request
+// Returns nil if valid, otherwise returns an error
+func (e *ChannelEventSeriesUpdate) Validate() error {
+	validate := validator.New()
+	return validate.Struct(e)
+}
+
+// ChannelEventSeriesDelete is the request body for deleting a calendar event series
+type ChannelEventSeriesDelete struct {
+	// When set, only this event and the following ones are deleted
+	CalendarEventID CalendarEventID `json:"calendarEventId,omitempty"`
+}
+
+// ChannelEventComment is the request body for creating or updating a calendar event comment
+type ChannelEventComment struct {
+	Content string `json:"content" validate:"min=1,max=10000"`
+}
+
+// Validate validates the channel event comment create/update request
+// Returns nil if valid, otherwise returns an error
+func (c *ChannelEventComment) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
 // ChannelEventsRequest is the request body for listing channel events
 type ChannelEventsRequest struct {
 	Before *time.Time `json:"before,omitempty"`
@@ -486,8 +1028,8 @@ type ServerSocialLink struct {
 
 // WebhookCreate is the request body for creating a webhook
 type WebhookCreate struct {
-	Name      string `json:"name" validate:"min=1,max=128"`
-	ChannelID string `json:"channelId"`
+	Name      string    `json:"name" validate:"min=1,max=128"`
+	ChannelID ChannelID `json:"channelId"`
 }
 
 // Validate validates the webhook create request
@@ -499,8 +1041,8 @@ func (w *WebhookCreate) Validate() error {
 
 // WebhookUpdate is the request body for updating a webhook
 type WebhookUpdate struct {
-	Name      string `json:"name" validate:"min=1,max=128"`
-	ChannelID string `json:"channelId"`
+	Name      string    `json:"name" validate:"min=1,max=128"`
+	ChannelID ChannelID `json:"channelId"`
 }
 
 // Validate validates the webhook update request
@@ -104,7 +104,8 @@ func constCase(name string) string {
 
 func isGuildedEvent(name string) bool {
 	switch {
-	case name == "Connect", name == "Disconnect", name == "Event", name == "RateLimit", name == "Interface", name == "Resume":
+	case name == "Connect", name == "Disconnect", name == "Event", name == "RateLimit", name == "Interface", name == "Resume", name == "ReconnectFailed",
+		name == "GatewayInvalidCursor", name == "GatewayInternalError":
 		return false
 	default:
 		return true
@@ -5,11 +5,11 @@ import "time"
 // User stores the data of a user.
 type User struct {
 	// The ID of the user
-	ID string `json:"id"`
+	ID UserID `json:"id"`
 
 	// The type of the user
 	// Can be "bot" or "user"
-	Type string `json:"type"`
+	Type UserType `json:"type"`
 
 	// The name of the user
 	Name string `json:"name"`
@@ -22,6 +22,26 @@ type User struct {
 
 	// The timestamp of when the user was created
 	CreatedAt time.Time `json:"createdAt"`
+
+	// The custom status of the user, nil if not set
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UserStatus stores the custom status of a user.
+type UserStatus struct {
+	// The text of the status
+	Content string `json:"content,omitempty"`
+
+	// The ID of the emote displayed with the status
+	EmoteID EmoteID `json:"emoteId"`
+
+	// The timestamp of when the status expires, nil if it does not
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// IsBot returns true if the user is a bot.
+func (u *User) IsBot() bool {
+	return u.Type == UserTypeBot
 }
 
 // Mention returns a string that can be used to mention the user.
@@ -31,5 +51,5 @@ func (u *User) Mention() string {
 
 // MentionEmbed returns a string that can be used to mention the user in an embed.
 func (u *User) MentionEmbed() string {
-	return "<@" + u.ID + ">"
+	return "<@" + string(u.ID) + ">"
 }
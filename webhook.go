@@ -0,0 +1,160 @@
+package guildrone
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookClient posts messages through a Guilded webhook.
+// It only needs the webhook ID and token, no bot Session is required.
+type WebhookClient struct {
+	// ID of the webhook
+	ID WebhookID
+
+	// Token of the webhook, see Webhook.Token
+	Token string
+
+	// Logging
+	Debug bool
+
+	// Maximum size in bytes of each file attached with ExecuteWithFiles, unlimited if zero.
+	MaxUploadSize int64
+
+	// REST API Client
+	Client    *http.Client
+	UserAgent string
+}
+
+// NewWebhookClient creates a new client for the webhook.
+// webhookID : The ID of a Webhook.
+// token     : The token of the Webhook.
+func NewWebhookClient(webhookID WebhookID, token string) *WebhookClient {
+	return &WebhookClient{
+		ID:            webhookID,
+		Token:         token,
+		MaxUploadSize: DefaultMaxUploadSize,
+		Client:        &http.Client{Timeout: (20 * time.Second)},
+		UserAgent:     "GuildedBot (https://github.com/FlameInTheDark/guildrone, v" + VERSION + ")",
+	}
+}
+
+// Execute posts a message through the webhook.
+// data : The message to post.
+func (w *WebhookClient) Execute(data *WebhookExecute) (*ChatMessage, error) {
+	body, err := Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.execute("application/json", bytes.NewReader(body))
+}
+
+// ExecuteWithFiles posts a message with file attachments through the webhook.
+// The files are streamed, none of them is held in memory as a whole.
+// Returns ErrFileTooLarge if a file is larger than MaxUploadSize.
+// data  : The message to post, may be nil.
+// files : The files to attach.
+func (w *WebhookClient) ExecuteWithFiles(data *WebhookExecute, files ...*File) (*ChatMessage, error) {
+	contentType, body, err := multipartBody(data, files, w.MaxUploadSize)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return w.execute(contentType, body)
+}
+
+// WebhookMessageCreate posts a text message through the webhook.
+// content : The message to post.
+func (w *WebhookClient) WebhookMessageCreate(content string) (*ChatMessage, error) {
+	return w.Execute(&WebhookExecute{Content: content})
+}
+
+// execute sends the request body to the webhook.
+func (w *WebhookClient) execute(contentType string, body io.Reader) (st *ChatMessage, err error) {
+	urlStr := EndpointWebhookExecute(w.ID, w.Token)
+	if w.Debug {
+		log.Printf("API REQUEST %8s :: %s\n", "POST", EndpointWebhookExecute(w.ID, "<token>"))
+	}
+
+	req, err := http.NewRequest("POST", urlStr, body)
+	if err != nil {
+		return nil, w.redactError(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", w.UserAgent)
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return nil, w.redactError(err)
+	}
+	defer func() {
+		err2 := resp.Body.Close()
+		if w.Debug && err2 != nil {
+			log.Println("error closing resp body")
+		}
+	}()
+
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
+	if w.Debug {
+		log.Printf("API RESPONSE  STATUS :: %s\n", resp.Status)
+		log.Printf("API RESPONSE    BODY :: [%s]\n\n\n", response)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	case http.StatusTooManyRequests:
+		after, _ := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		return nil, &RateLimitError{&RateLimit{RetryAfter: time.Duration(after) * time.Second, URL: EndpointWebhookExecute(w.ID, "<token>")}}
+	default:
+		// The request URL holds the token, keep it out of the error.
+		redacted := w.redactRequest(req)
+		r := *resp
+		r.Request = redacted
+		return nil, newRestError(redacted, &r, response)
+	}
+
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	err = unmarshal(response, &st)
+	return
+}
+
+// redact replaces the webhook token in s.
+func (w *WebhookClient) redact(s string) string {
+	if w.Token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, w.Token, "<token>")
+}
+
+// redactRequest returns a copy of req without the webhook token in its URL.
+func (w *WebhookClient) redactRequest(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	u := *req.URL
+	u.Path = w.redact(u.Path)
+	u.RawPath = w.redact(u.RawPath)
+	r.URL = &u
+	return r
+}
+
+// redactError removes the webhook token from the URL of a *url.Error.
+func (w *WebhookClient) redactError(err error) error {
+	if ue, ok := err.(*url.Error); ok {
+		return &url.Error{Op: ue.Op, URL: w.redact(ue.URL), Err: ue.Err}
+	}
+	return err
+}
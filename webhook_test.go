@@ -0,0 +1,62 @@
+package guildrone
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWebhookErrorsRedactToken(t *testing.T) {
+	const token = "s3cr3t-webhook-token"
+
+	tests := []struct {
+		name      string
+		transport roundTripFunc
+		wantREST  bool
+	}{
+		{"rest error", func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Status:     "400 Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"code":"BadRequest","message":"bad"}`)),
+				Request:    req,
+			}, nil
+		}, true},
+		{"transport error", func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWebhookClient("w1", token)
+			w.Client = &http.Client{Transport: tt.transport}
+
+			_, err := w.WebhookMessageCreate("hi")
+			if err == nil {
+				t.Fatal("WebhookMessageCreate() error = nil")
+			}
+			if strings.Contains(err.Error(), token) {
+				t.Errorf("error %q contains the webhook token", err)
+			}
+			var restErr *RESTError
+			if errors.As(err, &restErr) != tt.wantREST {
+				t.Fatalf("WebhookMessageCreate() error = %T, want a RESTError %v", err, tt.wantREST)
+			}
+			if tt.wantREST {
+				if strings.Contains(restErr.Request.URL.String(), token) || strings.Contains(restErr.Response.Request.URL.String(), token) {
+					t.Errorf("RESTError request URL contains the webhook token")
+				}
+			}
+		})
+	}
+}
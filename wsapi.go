@@ -3,6 +3,7 @@ package guildrone
 import (
 	"bytes"
 	"compress/zlib"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,13 +22,120 @@ var ErrWSAlreadyOpen = errors.New("web socket already opened")
 // that doesn't exist
 var ErrWSNotFound = errors.New("no websocket connection exists")
 
-type helloOp struct {
-	HeartbeatIntervalMs time.Duration `json:"heartbeatIntervalMs"`
+// ErrInvalidCursor is returned by Open when Guilded rejected the
+// last message ID used to replay missed events.
+var ErrInvalidCursor = errors.New("gateway rejected the last message id")
+
+// ErrGatewayInternal is returned by Open when Guilded reported an
+// internal error instead of the welcome message.
+var ErrGatewayInternal = errors.New("gateway internal error")
+
+// GatewayOp is an op code of a Guilded gateway message.
+// See: https://www.guilded.gg/docs/api/websockets
+type GatewayOp int
+
+const (
+	// GatewayOpEvent is a regular event message.
+	GatewayOpEvent GatewayOp = 0
+	// GatewayOpWelcome is the first message sent after connecting.
+	GatewayOpWelcome GatewayOp = 1
+	// GatewayOpResume is sent after reconnecting with a last message ID.
+	GatewayOpResume GatewayOp = 2
+	// GatewayOpInvalidCursor is sent when the last message ID is invalid or expired.
+	GatewayOpInvalidCursor GatewayOp = 8
+	// GatewayOpInternalError is sent when the gateway failed internally.
+	GatewayOpInternalError GatewayOp = 9
+)
+
+// String returns the name of the op code.
+func (op GatewayOp) String() string {
+	switch op {
+	case GatewayOpEvent:
+		return "Event"
+	case GatewayOpWelcome:
+		return "Welcome"
+	case GatewayOpResume:
+		return "Resume"
+	case GatewayOpInvalidCursor:
+		return "InvalidCursor"
+	case GatewayOpInternalError:
+		return "InternalError"
+	default:
+		return fmt.Sprintf("GatewayOp(%d)", int(op))
+	}
+}
+
+// GatewayCloseCode is a websocket close code of the Guilded gateway connection.
+type GatewayCloseCode int
+
+const (
+	GatewayCloseNormal          GatewayCloseCode = websocket.CloseNormalClosure
+	GatewayCloseGoingAway       GatewayCloseCode = websocket.CloseGoingAway
+	GatewayCloseProtocolError   GatewayCloseCode = websocket.CloseProtocolError
+	GatewayCloseUnsupportedData GatewayCloseCode = websocket.CloseUnsupportedData
+	GatewayCloseNoStatus        GatewayCloseCode = websocket.CloseNoStatusReceived
+	GatewayCloseAbnormal        GatewayCloseCode = websocket.CloseAbnormalClosure
+	GatewayCloseInvalidPayload  GatewayCloseCode = websocket.CloseInvalidFramePayloadData
+	GatewayClosePolicyViolation GatewayCloseCode = websocket.ClosePolicyViolation
+	GatewayCloseMessageTooBig   GatewayCloseCode = websocket.CloseMessageTooBig
+	GatewayCloseInternalError   GatewayCloseCode = websocket.CloseInternalServerErr
+	GatewayCloseServiceRestart  GatewayCloseCode = websocket.CloseServiceRestart
+	GatewayCloseTryAgainLater   GatewayCloseCode = websocket.CloseTryAgainLater
+)
+
+// String returns the name of the close code.
+func (c GatewayCloseCode) String() string {
+	switch c {
+	case GatewayCloseNormal:
+		return "Normal"
+	case GatewayCloseGoingAway:
+		return "GoingAway"
+	case GatewayCloseProtocolError:
+		return "ProtocolError"
+	case GatewayCloseUnsupportedData:
+		return "UnsupportedData"
+	case GatewayCloseNoStatus:
+		return "NoStatus"
+	case GatewayCloseAbnormal:
+		return "Abnormal"
+	case GatewayCloseInvalidPayload:
+		return "InvalidPayload"
+	case GatewayClosePolicyViolation:
+		return "PolicyViolation"
+	case GatewayCloseMessageTooBig:
+		return "MessageTooBig"
+	case GatewayCloseInternalError:
+		return "InternalError"
+	case GatewayCloseServiceRestart:
+		return "ServiceRestart"
+	case GatewayCloseTryAgainLater:
+		return "TryAgainLater"
+	default:
+		return fmt.Sprintf("GatewayCloseCode(%d)", int(c))
+	}
+}
+
+// gatewayErrorOp is the payload of the error op codes.
+type gatewayErrorOp struct {
+	Message string `json:"message"`
 }
 
 // Open creates a websocket connection to Guilded.
 // See: https://www.guilded.gg/docs/api/connecting
 func (s *Session) Open() error {
+	return s.open(context.Background())
+}
+
+// OpenAndWait creates a websocket connection to Guilded and blocks until
+// the welcome message has been processed and CurrentUser returns the bot
+// user, or ctx is done.
+func (s *Session) OpenAndWait(ctx context.Context) error {
+	return s.open(ctx)
+}
+
+// open creates a websocket connection to Guilded, giving up when ctx is done
+// before the welcome message has been processed.
+func (s *Session) open(ctx context.Context) error {
 	s.log(LogInformational, "called")
 
 	var err error
@@ -52,12 +160,16 @@ func (s *Session) Open() error {
 		if len(s.LastEventID) > 0 {
 			header.Add("guilded-last-message-id", s.LastEventID)
 		}
-		s.eventMu.Unlock()
+		s.eventMu.RUnlock()
 	}
-	s.wsConn, _, err = websocket.DefaultDialer.Dial(EndpointGuildedWebsocket, header)
+	var resp *http.Response
+	s.wsConn, resp, err = websocket.DefaultDialer.DialContext(ctx, EndpointGuildedWebsocket, header)
 	if err != nil {
 		s.log(LogError, "error connecting to gateway %s, %s", EndpointGuildedWebsocket, err)
 		s.wsConn = nil // Just to be safe.
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return ErrUnauthorized
+		}
 		return err
 	}
 
@@ -75,9 +187,28 @@ func (s *Session) Open() error {
 		}
 	}()
 
+	// Unblock the read below if ctx is done before the welcome arrives.
+	wsConn := s.wsConn
+	stopWatch := make(chan struct{})
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		select {
+		case <-ctx.Done():
+			wsConn.SetReadDeadline(time.Now())
+		case <-stopWatch:
+		}
+	}()
+
 	// The first response from Guilded should be an Op 1 (Hello) Packet.
 	// When processed by onEvent the heartbeat goroutine will be started.
 	mt, m, err := s.wsConn.ReadMessage()
+	close(stopWatch)
+	<-watchDone
+	if ctx.Err() != nil {
+		err = ctx.Err()
+		return err
+	}
 	if err != nil {
 		return err
 	}
@@ -85,16 +216,30 @@ func (s *Session) Open() error {
 	if err != nil {
 		return err
 	}
-	if e.Operation != 1 {
+	switch e.Operation {
+	case GatewayOpWelcome:
+	case GatewayOpInvalidCursor:
+		// onEvent has already cleared LastEventID, the next attempt
+		// connects without replaying.
+		err = ErrInvalidCursor
+		return err
+	case GatewayOpInternalError:
+		err = ErrGatewayInternal
+		return err
+	default:
 		err = fmt.Errorf("expecting Op 1, got Op %d instead", e.Operation)
 		return err
 	}
 	s.log(LogInformational, "Op 1 Hello Packet received from Guilded")
-	var h helloOp
-	if err = json.Unmarshal(e.RawData, &h); err != nil {
-		err = fmt.Errorf("error unmarshalling helloOp, %s", err)
+	var r Ready
+	if err = json.Unmarshal(e.RawData, &r); err != nil {
+		err = fmt.Errorf("error unmarshalling welcome, %s", err)
 		return err
 	}
+
+	s.userMu.Lock()
+	s.user = &r.User
+	s.userMu.Unlock()
 	//
 	//// Now we send either an Op 2 Identity if this is a brand new
 	//// connection or Op 6 Resume if we are resuming an existing connection.
@@ -129,6 +274,7 @@ func (s *Session) Open() error {
 	//}
 
 	s.handleEvent(connectEventType, &Connect{})
+	s.handleEvent(readyEventType, &r)
 
 	// Create listening chan outside of listen, as it needs to happen inside the
 	// mutex lock and needs to exist before calling heartbeat and listen
@@ -136,13 +282,30 @@ func (s *Session) Open() error {
 	s.listening = make(chan interface{})
 
 	// Start sending heartbeats and reading messages from Guilded.
-	go s.heartbeat(s.wsConn, s.listening, h.HeartbeatIntervalMs)
+	go s.heartbeat(s.wsConn, s.listening, time.Duration(r.HeartbeatIntervalMS))
 	go s.listen(s.wsConn, s.listening)
 
+	s.updateHealth(func(h *SessionHealth) { h.Connected = true })
+
 	s.log(LogInformational, "exiting")
 	return nil
 }
 
+// updateHealth changes the connection health reported by Manager.Health.
+func (s *Session) updateHealth(f func(h *SessionHealth)) {
+	s.healthMu.Lock()
+	f(&s.health)
+	s.healthMu.Unlock()
+}
+
+// CurrentUser returns the authenticated bot user, or nil until the
+// welcome message has been received.
+func (s *Session) CurrentUser() *BotUser {
+	s.userMu.RLock()
+	defer s.userMu.RUnlock()
+	return s.user
+}
+
 // onEvent is the "event handler" for all messages received on the
 // Guilded Gateway API websocket connection.
 //
@@ -177,9 +340,11 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 	}
 
 	if messageType == websocket.PongMessage {
+		now := time.Now().UTC()
 		s.Lock()
-		s.LastHeartbeatAck = time.Now().UTC()
+		s.LastHeartbeatAck = now
 		s.Unlock()
+		s.updateHealth(func(h *SessionHealth) { h.LastHeartbeatAck = now })
 		s.log(LogDebug, "got heartbeat ACK")
 		return nil, nil
 	}
@@ -192,21 +357,43 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 		return e, err
 	}
 
-	s.log(LogDebug, "Op: %d, MsgID: %d, Type: %s, Data: %s\n\n", e.Operation, e.MessageID, e.Type, string(e.RawData))
+	s.log(LogDebug, "Op: %d, MsgID: %s, Type: %s, Data: %s\n\n", e.Operation, e.MessageID, e.Type, string(e.RawData))
 
-	if s.ShouldReplayEventsOnReconnect {
+	// Only event messages carry a message ID.
+	if s.ShouldReplayEventsOnReconnect && e.MessageID != "" {
 		s.eventMu.Lock()
 		s.LastEventID = e.MessageID
 		s.eventMu.Unlock()
 	}
 
-	if e.Operation == 1 {
+	switch e.Operation {
+	case GatewayOpEvent:
+	case GatewayOpWelcome:
 		// Op1 is handled by Open()
 		return e, nil
-	}
-
-	if e.Operation == 2 {
-		s.handleEvent(resumeEventType, &Resume{})
+	case GatewayOpResume:
+		r := &Resume{}
+		if len(e.RawData) > 0 {
+			if err = json.Unmarshal(e.RawData, r); err != nil {
+				s.log(LogError, "error unmarshalling resume op, %s", err)
+			}
+		}
+		s.handleEvent(resumeEventType, r)
+		return e, nil
+	case GatewayOpInvalidCursor:
+		s.log(LogWarning, "gateway rejected last message id, events will not be replayed")
+		s.eventMu.Lock()
+		s.LastEventID = ""
+		s.eventMu.Unlock()
+		s.handleEvent(gatewayInvalidCursorEventType, &GatewayInvalidCursor{Message: s.gatewayErrorMessage(e)})
+		return e, nil
+	case GatewayOpInternalError:
+		s.log(LogWarning, "gateway reported an internal error")
+		s.handleEvent(gatewayInternalErrorEventType, &GatewayInternalError{Message: s.gatewayErrorMessage(e)})
+		return e, nil
+	default:
+		s.log(LogWarning, "unknown op: Op: %d, MsgID: %s, Data: %s", e.Operation, e.MessageID, string(e.RawData))
+		s.handleEvent(eventEventType, e)
 		return e, nil
 	}
 
@@ -219,9 +406,13 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 			s.log(LogError, "error unmarshalling %s event, %s", e.Type, err)
 		}
 
-		s.handleEvent(e.Type, e.Struct)
+		if s.ignoreEvent(e.Struct) {
+			s.log(LogDebug, "%s event filtered out", e.Type)
+		} else {
+			s.handleEvent(e.Type, e.Struct)
+		}
 	} else {
-		s.log(LogWarning, "unknown event: Op: %d, MsgID: %d, Type: %s, Data: %s", e.Operation, e.MessageID, e.Type, string(e.RawData))
+		s.log(LogWarning, "unknown event: Op: %d, MsgID: %s, Type: %s, Data: %s", e.Operation, e.MessageID, e.Type, string(e.RawData))
 	}
 
 	// For legacy reasons, we send the raw event also, this could be useful for handling unknown events.
@@ -230,6 +421,19 @@ func (s *Session) onEvent(messageType int, message []byte) (*Event, error) {
 	return e, nil
 }
 
+// gatewayErrorMessage returns the message of an error op, if any.
+func (s *Session) gatewayErrorMessage(e *Event) string {
+	if len(e.RawData) == 0 {
+		return ""
+	}
+
+	var op gatewayErrorOp
+	if err := json.Unmarshal(e.RawData, &op); err != nil {
+		s.log(LogWarning, "error unmarshalling %s op, %s", e.Operation, err)
+	}
+	return op.Message
+}
+
 // listen polls the websocket connection for events, it will stop when the
 // listening channel is closed, or an error occurs.
 func (s *Session) listen(wsConn *websocket.Conn, listening <-chan interface{}) {
@@ -252,9 +456,20 @@ func (s *Session) listen(wsConn *websocket.Conn, listening <-chan interface{}) {
 			if sameConnection {
 
 				s.log(LogWarning, "error reading from gateway %s websocket, %s", EndpointGuildedWebsocket, err)
+
+				// Surface the close code sent by Guilded on the Disconnect event.
+				d := &Disconnect{Code: GatewayCloseAbnormal}
+				var ce *websocket.CloseError
+				if errors.As(err, &ce) {
+					d.Code = GatewayCloseCode(ce.Code)
+					d.Reason = ce.Text
+					d.ByServer = true
+					s.log(LogInformational, "gateway closed the connection, %s (%d) %s", d.Code, ce.Code, ce.Text)
+				}
+
 				// There has been an error reading, close the websocket so that
 				// OnDisconnect event is emitted.
-				err := s.Close()
+				err := s.closeWithCode(websocket.CloseNormalClosure, d)
 				if err != nil {
 					s.log(LogWarning, "error closing session connection, %s", err)
 				}
@@ -286,9 +501,11 @@ const FailedHeartbeatAcks time.Duration = 5 * time.Millisecond
 func (s *Session) heartbeat(wsConn *websocket.Conn, listening <-chan interface{}, heartbeatIntervalMsec time.Duration) {
 
 	wsConn.SetPongHandler(func(string) error {
+		now := time.Now().UTC()
 		s.Lock()
-		s.LastHeartbeatAck = time.Now().UTC()
+		s.LastHeartbeatAck = now
 		s.Unlock()
+		s.updateHealth(func(h *SessionHealth) { h.LastHeartbeatAck = now })
 		s.log(LogDebug, "got heartbeat ACK")
 		return nil
 	})
@@ -310,15 +527,17 @@ func (s *Session) heartbeat(wsConn *websocket.Conn, listening <-chan interface{}
 		s.log(LogDebug, "sending gateway websocket heartbeat seq")
 		s.wsMutex.Lock()
 		s.LastHeartbeatSent = time.Now().UTC()
+		sent := s.LastHeartbeatSent
 		err = wsConn.WriteMessage(websocket.PingMessage, []byte{})
 		s.wsMutex.Unlock()
+		s.updateHealth(func(h *SessionHealth) { h.LastHeartbeatSent = sent })
 		if err != nil || time.Now().UTC().Sub(last) > (heartbeatIntervalMsec*FailedHeartbeatAcks) {
 			if err != nil {
 				s.log(LogError, "error sending heartbeat to gateway %s, %s", EndpointGuildedWebsocket, err)
 			} else {
 				s.log(LogError, "haven't gotten a heartbeat ACK in %v, triggering a reconnection", time.Now().UTC().Sub(last))
 			}
-			s.Close()
+			s.closeWithCode(websocket.CloseNormalClosure, nil)
 			s.reconnect()
 			return
 		}
@@ -336,13 +555,29 @@ func (s *Session) heartbeat(wsConn *websocket.Conn, listening <-chan interface{}
 }
 
 // Close closes a websocket and stops all listening/heartbeat goroutines.
+// A running reconnect loop is cancelled as well.
 func (s *Session) Close() error {
 	return s.CloseWithCode(websocket.CloseNormalClosure)
 }
 
 // CloseWithCode closes a websocket using the provided closeCode and stops all
-// listening/heartbeat goroutines.
-func (s *Session) CloseWithCode(closeCode int) (err error) {
+// listening/heartbeat goroutines. A running reconnect loop is cancelled as well.
+func (s *Session) CloseWithCode(closeCode int) error {
+	s.reconnectMu.Lock()
+	if s.reconnectStop != nil {
+		s.log(LogInformational, "cancelling reconnect")
+		close(s.reconnectStop)
+		s.reconnectStop = nil
+	}
+	s.reconnectMu.Unlock()
+
+	return s.closeWithCode(closeCode, nil)
+}
+
+// closeWithCode closes the websocket without cancelling reconnects,
+// it is used internally before reconnecting.
+// d is emitted as the Disconnect event, if nil one is built from closeCode.
+func (s *Session) closeWithCode(closeCode int, d *Disconnect) (err error) {
 
 	s.log(LogInformational, "called")
 	s.Lock()
@@ -377,49 +612,101 @@ func (s *Session) CloseWithCode(closeCode int) (err error) {
 		}
 
 		s.wsConn = nil
+		s.updateHealth(func(h *SessionHealth) { h.Connected = false })
 	}
 
 	s.Unlock()
 
+	if d == nil {
+		d = &Disconnect{Code: GatewayCloseCode(closeCode)}
+	}
+
 	s.log(LogInformational, "emit disconnect event")
-	s.handleEvent(disconnectEventType, &Disconnect{})
+	s.handleEvent(disconnectEventType, d)
 
 	return
 }
 
+// reconnect reopens the websocket following the session ReconnectPolicy.
+// A ReconnectFailed event is emitted when it gives up.
 func (s *Session) reconnect() {
 
 	s.log(LogInformational, "called")
 
-	var err error
+	if !s.ShouldReconnectOnError {
+		return
+	}
 
-	if s.ShouldReconnectOnError {
+	// Only one reconnect loop may run at a time.
+	s.reconnectMu.Lock()
+	if s.reconnectStop != nil {
+		s.reconnectMu.Unlock()
+		s.log(LogInformational, "reconnect already in progress")
+		return
+	}
+	stop := make(chan struct{})
+	s.reconnectStop = stop
+	s.reconnectMu.Unlock()
 
-		wait := time.Duration(1)
+	defer func() {
+		s.reconnectMu.Lock()
+		if s.reconnectStop == stop {
+			s.reconnectStop = nil
+		}
+		s.reconnectMu.Unlock()
+	}()
 
-		for {
-			s.log(LogInformational, "trying to reconnect to gateway")
+	policy := s.reconnectPolicy()
+	start := time.Now()
 
-			err = s.Open()
-			if err == nil {
-				s.log(LogInformational, "successfully reconnected to gateway")
-				return
-			}
+	for attempt := 1; ; attempt++ {
+		s.log(LogInformational, "trying to reconnect to gateway, attempt %d", attempt)
 
-			// Certain race conditions can call reconnect() twice. If this happens, we
-			// just break out of the reconnect loop
-			if err == ErrWSAlreadyOpen {
-				s.log(LogInformational, "Websocket already exists, no need to reconnect")
-				return
-			}
+		err := s.Open()
+		if err == nil {
+			s.log(LogInformational, "successfully reconnected to gateway")
+			return
+		}
 
-			s.log(LogError, "error reconnecting to gateway, %s", err)
+		// Certain race conditions can call reconnect() twice. If this happens, we
+		// just break out of the reconnect loop
+		if err == ErrWSAlreadyOpen {
+			s.log(LogInformational, "Websocket already exists, no need to reconnect")
+			return
+		}
 
-			<-time.After(wait * time.Second)
-			wait *= 2
-			if wait > 600 {
-				wait = 600
-			}
+		s.log(LogError, "error reconnecting to gateway, %s", err)
+
+		// Retrying with a rejected token will never succeed.
+		if errors.Is(err, ErrUnauthorized) {
+			s.reconnectFailed(attempt, start, err)
+			return
+		}
+
+		// An ErrInvalidCursor has already cleared the cursor, so the next
+		// attempt connects without replaying. It still counts against the
+		// policy so a gateway that keeps rejecting us is not hammered.
+		wait, ok := policy.NextDelay(attempt, time.Since(start))
+		if !ok {
+			s.reconnectFailed(attempt, start, fmt.Errorf("%w: %s", ErrReconnectGaveUp, err))
+			return
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-stop:
+			s.reconnectFailed(attempt, start, ErrReconnectCancelled)
+			return
 		}
 	}
 }
+
+// reconnectFailed emits the terminal ReconnectFailed event.
+func (s *Session) reconnectFailed(attempts int, start time.Time, err error) {
+	s.log(LogWarning, "giving up reconnecting after %d attempts, %s", attempts, err)
+	s.handleEvent(reconnectFailedEventType, &ReconnectFailed{
+		Attempts: attempts,
+		Elapsed:  time.Since(start),
+		Err:      err,
+	})
+}